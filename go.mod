@@ -2,7 +2,7 @@ module github.com/sghaida/fpv2
 
 retract v0.0.1
 
-go 1.20
+go 1.23
 
 require github.com/stretchr/testify v1.8.4
 
@@ -0,0 +1,157 @@
+// Package src ...
+package src
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrorUnboundName if a pipeline step reads a name that was never bound, or bound to a value of a different type
+var ErrorUnboundName = errors.New("unbound name")
+
+// Bindings holds the named values bound along a pipeline, so later steps can read them
+type Bindings map[string]any
+
+// Bound return the value bound to name, it panics with ErrorUnboundName if name is missing
+// or bound to a value that is not of type T, so a misspelled name doesn't run the next steps on a zero value
+func Bound[T any](bindings Bindings, name string) T {
+	value, ok := bindings[name]
+	if !ok {
+		panic(fmt.Errorf("%w: %q is not bound", ErrorUnboundName, name))
+	}
+	typed, ok := value.(T)
+	if !ok {
+		panic(fmt.Errorf("%w: %q is bound to %T not %s", ErrorUnboundName, name, value, typeName[T]()))
+	}
+	return typed
+}
+
+// LookupBound return the value bound to name and True, or the zero value of T and False
+// if name is missing or bound to a value that is not of type T
+func LookupBound[T any](bindings Bindings, name string) (T, bool) {
+	value, ok := bindings[name].(T)
+	return value, ok
+}
+
+// clone return a shallow copy of the Bindings
+func (b Bindings) clone() Bindings {
+	out := make(Bindings, len(b)+1)
+	for k, v := range b {
+		out[k] = v
+	}
+	return out
+}
+
+// with return a copy of the Bindings that binds value to name
+func (b Bindings) with(name string, value any) Bindings {
+	out := b.clone()
+	out[name] = value
+	return out
+}
+
+// EitherChain is a pipeline builder over Either that replaces nested EitherFlatMap closures
+// the steps are applied on the Right value and the pipeline short-circuits on the first Left
+// e.g.
+//
+//	order := Chain(findUser(id)).Bind("user").
+//		ThenWith(func(b Bindings, u User) Either[error, User] { return checkCredit(u) }).
+//		Run()
+type EitherChain[L, R any] struct {
+	either   Either[L, R]
+	bindings Bindings
+}
+
+// Chain starts a pipeline from either
+func Chain[L, R any](either Either[L, R]) EitherChain[L, R] {
+	return EitherChain[L, R]{either: either, bindings: Bindings{}}
+}
+
+// Then applies step on the current Right value, it is skipped if the pipeline is already Left
+func (c EitherChain[L, R]) Then(step EitherFlatMapFn[L, R, R]) EitherChain[L, R] {
+	return c.ThenWith(func(_ Bindings, value R) Either[L, R] {
+		return step(value)
+	})
+}
+
+// ThenWith behaves like Then while step can read the values bound by the previous steps
+func (c EitherChain[L, R]) ThenWith(step func(Bindings, R) Either[L, R]) EitherChain[L, R] {
+	return ChainThen(c, step)
+}
+
+// Bind binds the current Right value to name, it is skipped if the pipeline is already Left
+func (c EitherChain[L, R]) Bind(name string) EitherChain[L, R] {
+	if c.either.IsLeft() {
+		return c
+	}
+	return EitherChain[L, R]{either: c.either, bindings: c.bindings.with(name, c.either.right)}
+}
+
+// Bindings return the values bound so far
+func (c EitherChain[L, R]) Bindings() Bindings {
+	return c.bindings.clone()
+}
+
+// Run return the result of the pipeline
+func (c EitherChain[L, R]) Run() Either[L, R] {
+	return c.either
+}
+
+// ChainThen applies step on the current Right value and changes the type of the pipeline to S
+// it is skipped if the pipeline is already Left
+func ChainThen[L, R, S any](c EitherChain[L, R], step func(Bindings, R) Either[L, S]) EitherChain[L, S] {
+	either := EitherFlatMap(c.either, func(value R) Either[L, S] {
+		return step(c.bindings, value)
+	})
+	return EitherChain[L, S]{either: either, bindings: c.bindings}
+}
+
+// OptionChain is a pipeline builder over Option that replaces nested OptionFlatMap closures
+// the steps are applied on the Some value and the pipeline short-circuits on the first None
+type OptionChain[A any] struct {
+	option   Option[A]
+	bindings Bindings
+}
+
+// ChainOption starts a pipeline from option
+func ChainOption[A any](option Option[A]) OptionChain[A] {
+	return OptionChain[A]{option: option, bindings: Bindings{}}
+}
+
+// Then applies step on the current Some value, it is skipped if the pipeline is already None
+func (c OptionChain[A]) Then(step OptionFlatMapperFn[A, A]) OptionChain[A] {
+	return c.ThenWith(func(_ Bindings, value A) Option[A] {
+		return step(value)
+	})
+}
+
+// ThenWith behaves like Then while step can read the values bound by the previous steps
+func (c OptionChain[A]) ThenWith(step func(Bindings, A) Option[A]) OptionChain[A] {
+	return OptionChainThen(c, step)
+}
+
+// Bind binds the current Some value to name, it is skipped if the pipeline is already None
+func (c OptionChain[A]) Bind(name string) OptionChain[A] {
+	if c.option.IsNone() {
+		return c
+	}
+	return OptionChain[A]{option: c.option, bindings: c.bindings.with(name, c.option.value)}
+}
+
+// Bindings return the values bound so far
+func (c OptionChain[A]) Bindings() Bindings {
+	return c.bindings.clone()
+}
+
+// Run return the result of the pipeline
+func (c OptionChain[A]) Run() Option[A] {
+	return c.option
+}
+
+// OptionChainThen applies step on the current Some value and changes the type of the pipeline to B
+// it is skipped if the pipeline is already None
+func OptionChainThen[A, B any](c OptionChain[A], step func(Bindings, A) Option[B]) OptionChain[B] {
+	option := OptionFlatMap(c.option, func(value A) Option[B] {
+		return step(c.bindings, value)
+	})
+	return OptionChain[B]{option: option, bindings: c.bindings}
+}
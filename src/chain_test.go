@@ -0,0 +1,204 @@
+package src
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+type chainTestUser struct {
+	ID    int
+	Name  string
+	Limit int
+}
+
+func TestBound(t *testing.T) {
+	bindings := Bindings{"id": 10, "name": "saddam"}
+
+	assert.Equal(t, 10, Bound[int](bindings, "id"))
+	assert.Equal(t, "saddam", Bound[string](bindings, "name"))
+
+	assertUnbound := func(message string, fn func()) {
+		defer func() {
+			err, ok := recover().(error)
+			assert.True(t, ok)
+			assert.ErrorIs(t, err, ErrorUnboundName)
+			assert.EqualError(t, err, message)
+		}()
+		fn()
+	}
+	assertUnbound(`unbound name: "nmae" is not bound`, func() { Bound[string](bindings, "nmae") })
+	assertUnbound(`unbound name: "name" is bound to string not int`, func() { Bound[int](bindings, "name") })
+}
+
+func TestLookupBound(t *testing.T) {
+	bindings := Bindings{"id": 10}
+
+	id, ok := LookupBound[int](bindings, "id")
+	assert.True(t, ok)
+	assert.Equal(t, 10, id)
+
+	_, ok = LookupBound[int](bindings, "ID")
+	assert.False(t, ok)
+	_, ok = LookupBound[string](bindings, "id")
+	assert.False(t, ok)
+}
+
+func TestChain_MisspelledBinding(t *testing.T) {
+	assert.Panics(t, func() {
+		Chain(Right[error, int](10)).Bind("id").
+			ThenWith(func(b Bindings, value int) Either[error, int] {
+				return Right[error, int](value + Bound[int](b, "Id"))
+			}).
+			Run()
+	})
+}
+
+func TestChain(t *testing.T) {
+	errNotFound := errors.New("not found")
+	errLimit := errors.New("limit exceeded")
+
+	findUser := func(id int) Either[error, chainTestUser] {
+		if id <= 0 {
+			return Left[error, chainTestUser](errNotFound)
+		}
+		return Right[error, chainTestUser](chainTestUser{ID: id, Name: "saddam", Limit: 100})
+	}
+	checkLimit := func(amount int) func(chainTestUser) Either[error, chainTestUser] {
+		return func(user chainTestUser) Either[error, chainTestUser] {
+			if amount > user.Limit {
+				return Left[error, chainTestUser](errLimit)
+			}
+			return Right[error, chainTestUser](user)
+		}
+	}
+
+	t.Run("all steps succeed", func(t *testing.T) {
+		result := Chain(findUser(1)).
+			Then(checkLimit(50)).
+			Then(checkLimit(80)).
+			Run()
+		assert.True(t, result.IsRight())
+		assert.Equal(t, 1, result.right.ID)
+	})
+
+	t.Run("short circuit on first left", func(t *testing.T) {
+		calls := 0
+		result := Chain(findUser(1)).
+			Then(checkLimit(500)).
+			Then(func(user chainTestUser) Either[error, chainTestUser] {
+				calls++
+				return Right[error, chainTestUser](user)
+			}).
+			Run()
+		assert.True(t, result.IsLeft())
+		assert.Equal(t, errLimit, result.left)
+		assert.Equal(t, 0, calls)
+	})
+
+	t.Run("left start", func(t *testing.T) {
+		result := Chain(findUser(0)).Then(checkLimit(50)).Run()
+		assert.Equal(t, errNotFound, result.left)
+	})
+
+	t.Run("bindings", func(t *testing.T) {
+		chain := Chain(findUser(1)).
+			Bind("user").
+			Then(func(user chainTestUser) Either[error, chainTestUser] {
+				user.Name = "changed"
+				return Right[error, chainTestUser](user)
+			}).
+			ThenWith(func(b Bindings, user chainTestUser) Either[error, chainTestUser] {
+				original := Bound[chainTestUser](b, "user")
+				user.Name = original.Name + " -> " + user.Name
+				return Right[error, chainTestUser](user)
+			})
+		result := chain.Run()
+		assert.Equal(t, "saddam -> changed", result.right.Name)
+		assert.Equal(t, "saddam", Bound[chainTestUser](chain.Bindings(), "user").Name)
+	})
+
+	t.Run("bind is skipped on left", func(t *testing.T) {
+		chain := Chain(findUser(0)).Bind("user")
+		_, ok := chain.Bindings()["user"]
+		assert.False(t, ok)
+	})
+
+	t.Run("typed steps", func(t *testing.T) {
+		greeting := ChainThen(
+			ChainThen(Chain(Right[error, string]("10")).Bind("raw"),
+				func(_ Bindings, value string) Either[error, int] {
+					return FromError(strconv.Atoi(value))
+				}).Bind("id"),
+			func(b Bindings, id int) Either[error, string] {
+				return Right[error, string](fmt.Sprintf("%s=%d", Bound[string](b, "raw"), id))
+			},
+		).Run()
+		assert.Equal(t, "10=10", greeting.right)
+
+		failed := ChainThen(Chain(Right[error, string]("x")), func(_ Bindings, value string) Either[error, int] {
+			return FromError(strconv.Atoi(value))
+		}).Run()
+		assert.ErrorIs(t, failed.left, strconv.ErrSyntax)
+	})
+
+	t.Run("bindings are not shared between branches", func(t *testing.T) {
+		base := Chain(Right[error, int](1)).Bind("a")
+		left := base.Bind("b")
+		right := base.Bind("c")
+
+		assert.Len(t, base.Bindings(), 1)
+		assert.Len(t, left.Bindings(), 2)
+		assert.Len(t, right.Bindings(), 2)
+		_, ok := right.Bindings()["b"]
+		assert.False(t, ok)
+	})
+}
+
+func TestChainOption(t *testing.T) {
+	lookup := func(m map[string]string) func(string) Option[string] {
+		return func(key string) Option[string] {
+			return FromMap(m, key)
+		}
+	}
+	aliases := lookup(map[string]string{"a": "b", "b": "c"})
+
+	t.Run("all steps succeed", func(t *testing.T) {
+		result := ChainOption(NewOptional("a")).Then(aliases).Then(aliases).Run()
+		assert.Equal(t, NewOptional("c"), result)
+	})
+
+	t.Run("short circuit on first none", func(t *testing.T) {
+		calls := 0
+		result := ChainOption(NewOptional("a")).
+			Then(aliases).
+			Then(aliases).
+			Then(aliases).
+			Then(func(value string) Option[string] {
+				calls++
+				return NewOptional(value)
+			}).
+			Run()
+		assert.True(t, result.IsNone())
+		assert.Equal(t, 0, calls)
+	})
+
+	t.Run("bindings and typed steps", func(t *testing.T) {
+		chain := OptionChainThen(
+			ChainOption(NewOptional("a")).Bind("start").Then(aliases).Bind("next"),
+			func(b Bindings, value string) Option[int] {
+				return NewOptional(len(Bound[string](b, "start") + Bound[string](b, "next") + value))
+			},
+		)
+		assert.Equal(t, NewOptional(3), chain.Run())
+		assert.Equal(t, Bindings{"start": "a", "next": "b"}, chain.Bindings())
+	})
+
+	t.Run("bind is skipped on none", func(t *testing.T) {
+		chain := ChainOption(None[string]()).Bind("start")
+		assert.Empty(t, chain.Bindings())
+		assert.True(t, chain.Run().IsNone())
+	})
+}
@@ -0,0 +1,80 @@
+// Package src ...
+package src
+
+import (
+	"cmp"
+	"github.com/sghaida/fpv2/src/utils"
+)
+
+// OptionEqual return True if both Options are None or both are Some with equal values
+// Options can be compared using == and used as map keys when A is comparable, since None is the zero value of Option
+func OptionEqual[A comparable](a, b Option[A]) bool {
+	return OptionEqualFunc(a, b, func(x, y A) bool {
+		return x == y
+	})
+}
+
+// OptionEqualFunc behaves like OptionEqual while using eq to compare the values
+func OptionEqualFunc[A any](a, b Option[A], eq func(A, A) bool) bool {
+	if a.IsNone() || b.IsNone() {
+		return a.IsNone() == b.IsNone()
+	}
+	return eq(a.value, b.value)
+}
+
+// OptionCompare compares 2 Options where None sorts before any Some
+// and Some values are compared using cmp.Compare, it can be used with sort.Slice and slices.SortFunc
+func OptionCompare[A cmp.Ordered](a, b Option[A]) int {
+	return OptionCompareFunc(a, b, cmp.Compare[A])
+}
+
+// OptionCompareFunc behaves like OptionCompare while using compare to compare the values
+func OptionCompareFunc[A any](a, b Option[A], compare utils.Comparator[A]) int {
+	switch {
+	case a.IsNone() && b.IsNone():
+		return 0
+	case a.IsNone():
+		return -1
+	case b.IsNone():
+		return +1
+	}
+	return compare(a.value, b.value)
+}
+
+// EitherEqual return True if both Eithers are on the same side with equal values
+func EitherEqual[A, B comparable](a, b Either[A, B]) bool {
+	return EitherEqualFunc(a, b,
+		func(x, y A) bool { return x == y },
+		func(x, y B) bool { return x == y },
+	)
+}
+
+// EitherEqualFunc behaves like EitherEqual while using eqLeft and eqRight to compare the values
+func EitherEqualFunc[A, B any](a, b Either[A, B], eqLeft func(A, A) bool, eqRight func(B, B) bool) bool {
+	if a.IsRight() != b.IsRight() {
+		return false
+	}
+	if a.IsRight() {
+		return eqRight(a.right, b.right)
+	}
+	return eqLeft(a.left, b.left)
+}
+
+// EitherCompare compares 2 Eithers where Left sorts before any Right
+// and values on the same side are compared using cmp.Compare
+func EitherCompare[A, B cmp.Ordered](a, b Either[A, B]) int {
+	return EitherCompareFunc(a, b, cmp.Compare[A], cmp.Compare[B])
+}
+
+// EitherCompareFunc behaves like EitherCompare while using cmpLeft and cmpRight to compare the values
+func EitherCompareFunc[A, B any](a, b Either[A, B], cmpLeft utils.Comparator[A], cmpRight utils.Comparator[B]) int {
+	switch {
+	case a.IsLeft() && b.IsRight():
+		return -1
+	case a.IsRight() && b.IsLeft():
+		return +1
+	case a.IsRight():
+		return cmpRight(a.right, b.right)
+	}
+	return cmpLeft(a.left, b.left)
+}
@@ -0,0 +1,135 @@
+package src
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"math"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestOptionEqual(t *testing.T) {
+	var zero Option[int]
+
+	assert.True(t, OptionEqual(NewOptional(10), NewOptional(10)))
+	assert.False(t, OptionEqual(NewOptional(10), NewOptional(11)))
+	assert.False(t, OptionEqual(NewOptional(10), None[int]()))
+	assert.False(t, OptionEqual(None[int](), NewOptional(10)))
+	assert.True(t, OptionEqual(None[int](), None[int]()))
+	assert.True(t, OptionEqual(zero, None[int]()))
+}
+
+func TestOptionEqualFunc(t *testing.T) {
+	eq := func(a, b []int) bool {
+		return len(a) == len(b)
+	}
+	assert.True(t, OptionEqualFunc(NewOptional([]int{1}), NewOptional([]int{2}), eq))
+	assert.False(t, OptionEqualFunc(NewOptional([]int{1}), NewOptional([]int{1, 2}), eq))
+	assert.False(t, OptionEqualFunc(NewOptional([]int{1}), None[[]int](), eq))
+	assert.True(t, OptionEqualFunc(None[[]int](), None[[]int](), eq))
+}
+
+func TestOptionCompare(t *testing.T) {
+	assert.Equal(t, 0, OptionCompare(None[int](), None[int]()))
+	assert.Equal(t, -1, OptionCompare(None[int](), NewOptional(-10)))
+	assert.Equal(t, +1, OptionCompare(NewOptional(-10), None[int]()))
+	assert.Equal(t, -1, OptionCompare(NewOptional(1), NewOptional(2)))
+	assert.Equal(t, 0, OptionCompare(NewOptional("a"), NewOptional("a")))
+	assert.Equal(t, +1, OptionCompare(NewOptional(2.5), NewOptional(1.5)))
+	assert.Equal(t, -1, OptionCompare(NewOptional(math.NaN()), NewOptional(1.5)))
+
+	t.Run("sort", func(t *testing.T) {
+		options := []Option[int]{NewOptional(3), None[int](), NewOptional(1), None[int](), NewOptional(2)}
+		sort.Slice(options, func(i, j int) bool {
+			return OptionCompare(options[i], options[j]) < 0
+		})
+		assert.Equal(t, []Option[int]{None[int](), None[int](), NewOptional(1), NewOptional(2), NewOptional(3)}, options)
+	})
+}
+
+func TestOptionCompareFunc(t *testing.T) {
+	byLength := func(a, b string) int {
+		return len(a) - len(b)
+	}
+	assert.Less(t, OptionCompareFunc(NewOptional("b"), NewOptional("aa"), byLength), 0)
+	assert.Equal(t, -1, OptionCompareFunc(None[string](), NewOptional("aa"), byLength))
+	assert.Equal(t, 0, OptionCompareFunc(NewOptional("a"), NewOptional("b"), byLength))
+}
+
+func TestOption_MapKey(t *testing.T) {
+	var zero Option[string]
+	var omitted struct {
+		Name Option[string] `json:"name"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(`{}`), &omitted))
+
+	counts := map[Option[string]]int{}
+	options := []Option[string]{NewOptional("a"), None[string](), NewOptional("a"), zero, omitted.Name}
+	for _, option := range options {
+		counts[option]++
+	}
+	assert.Equal(t, map[Option[string]]int{NewOptional("a"): 2, None[string](): 3}, counts)
+	assert.True(t, zero == None[string]())
+}
+
+func TestEitherEqual(t *testing.T) {
+	errMissing := errors.New("missing")
+
+	assert.True(t, EitherEqual(Right[error, int](10), Right[error, int](10)))
+	assert.False(t, EitherEqual(Right[error, int](10), Right[error, int](11)))
+	assert.True(t, EitherEqual(Left[error, int](errMissing), Left[error, int](errMissing)))
+	assert.False(t, EitherEqual(Left[error, int](errMissing), Left[error, int](errors.New("missing"))))
+	assert.False(t, EitherEqual(Left[error, int](errMissing), Right[error, int](10)))
+}
+
+func TestEitherEqualFunc(t *testing.T) {
+	sameMessage := func(a, b error) bool { return a.Error() == b.Error() }
+	sameLength := func(a, b []int) bool { return len(a) == len(b) }
+
+	assert.True(t, EitherEqualFunc(
+		Left[error, []int](errors.New("missing")), Left[error, []int](errors.New("missing")), sameMessage, sameLength,
+	))
+	assert.True(t, EitherEqualFunc(
+		Right[error, []int]([]int{1}), Right[error, []int]([]int{2}), sameMessage, sameLength,
+	))
+	assert.False(t, EitherEqualFunc(
+		Right[error, []int]([]int{1}), Left[error, []int](errors.New("missing")), sameMessage, sameLength,
+	))
+}
+
+func TestEitherCompare(t *testing.T) {
+	assert.Equal(t, -1, EitherCompare(Left[string, int]("z"), Right[string, int](1)))
+	assert.Equal(t, +1, EitherCompare(Right[string, int](1), Left[string, int]("a")))
+	assert.Equal(t, -1, EitherCompare(Left[string, int]("a"), Left[string, int]("b")))
+	assert.Equal(t, +1, EitherCompare(Right[string, int](2), Right[string, int](1)))
+	assert.Equal(t, 0, EitherCompare(Right[string, int](1), Right[string, int](1)))
+
+	t.Run("sort", func(t *testing.T) {
+		eithers := []Either[string, int]{
+			Right[string, int](2), Left[string, int]("b"), Right[string, int](1), Left[string, int]("a"),
+		}
+		sort.Slice(eithers, func(i, j int) bool {
+			return EitherCompare(eithers[i], eithers[j]) < 0
+		})
+		assert.Equal(t, []Either[string, int]{
+			Left[string, int]("a"), Left[string, int]("b"), Right[string, int](1), Right[string, int](2),
+		}, eithers)
+	})
+}
+
+func TestEitherCompareFunc(t *testing.T) {
+	byMessage := func(a, b error) int { return strings.Compare(a.Error(), b.Error()) }
+	byLength := func(a, b []int) int { return len(a) - len(b) }
+
+	assert.Equal(t, -1, EitherCompareFunc(
+		Left[error, []int](errors.New("a")), Left[error, []int](errors.New("b")), byMessage, byLength,
+	))
+	assert.Greater(t, EitherCompareFunc(
+		Right[error, []int]([]int{1, 2}), Right[error, []int]([]int{1}), byMessage, byLength,
+	), 0)
+	assert.Equal(t, -1, EitherCompareFunc(
+		Left[error, []int](errors.New("z")), Right[error, []int]([]int{1}), byMessage, byLength,
+	))
+}
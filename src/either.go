@@ -3,11 +3,11 @@ package src
 
 import (
 	"errors"
+	"fmt"
+	"github.com/sghaida/fpv2/src/collections/list"
 	"github.com/sghaida/fpv2/src/utils"
 )
 
-// TODO Add String
-
 // typeSide identifies if Either is left or isRight
 type typeSide string
 
@@ -21,6 +21,8 @@ var (
 	ErrorLeftValue = errors.New("left Value is presented")
 	// ErrorRightValue if right value is presented instead of left
 	ErrorRightValue = errors.New("right Value is presented")
+	// ErrorPanicRecovered if a panic was recovered by TryCatch
+	ErrorPanicRecovered = errors.New("panic recovered")
 )
 
 // Either is composite type contains left[A] and right[B]
@@ -42,20 +44,65 @@ func Left[A, B any](value A) Either[A, B] {
 }
 
 // Right create Either from Right value if value is presented and Left if Nil or a pointer
+// the Left holds ErrorLeftValue if A can hold an error, otherwise it holds the zero value of A
+// use RightOrElse to decide on the Left value, or RightUnchecked to skip the checks
 func Right[A, B any](value B) Either[A, B] {
+	left, _ := any(ErrorLeftValue).(A)
+	return RightOrElse(value, left)
+}
 
+// RightOrElse create Either from Right value if value is presented and Left(leftIfEmpty) if Nil or a pointer
+func RightOrElse[A, B any](value B, leftIfEmpty A) Either[A, B] {
 	if utils.IsNilOrZeroValue(value) || utils.IsPtr(value) {
-		return Either[A, B]{
-			left: any(ErrorLeftValue).(A),
-			side: isLeftSided,
-		}
+		return Left[A, B](leftIfEmpty)
 	}
+	return RightUnchecked[A, B](value)
+}
+
+// RightUnchecked create Either from Right value without any checks
+// so nil values, zero values and pointers end up on the Right
+func RightUnchecked[A, B any](value B) Either[A, B] {
 	return Either[A, B]{
 		right: value,
 		side:  isRightSided,
 	}
 }
 
+// FromError create Either from the value, err idiom, Left if err is not nil and Right otherwise
+// err decides the side, so pointers, nil and zero values are kept on the Right
+// e.g.
+//
+//	either := FromError(strconv.Atoi(value))
+func FromError[A any](value A, err error) Either[error, A] {
+	if err != nil {
+		return Left[error, A](err)
+	}
+	return RightUnchecked[error, A](value)
+}
+
+// TryCatch executes fn and return its result as Right even if it is nil or a pointer, if fn panics the panic is recovered and returned as Left
+// the Left wraps ErrorPanicRecovered and the panic value if it is an error
+func TryCatch[A any](fn func() A) (either Either[error, A]) {
+	defer func() {
+		if r := recover(); r != nil {
+			if err, ok := r.(error); ok {
+				either = Left[error, A](fmt.Errorf("%w: %w", ErrorPanicRecovered, err))
+				return
+			}
+			either = Left[error, A](fmt.Errorf("%w: %v", ErrorPanicRecovered, r))
+		}
+	}()
+	return RightUnchecked[error, A](fn())
+}
+
+// String return String representation of the Either, Left(value) or Right(value)
+func (e Either[A, B]) String() string {
+	if e.IsLeft() {
+		return fmt.Sprintf("Left(%v)", e.left)
+	}
+	return fmt.Sprintf("Right(%v)", e.right)
+}
+
 // IsLeft return True if value is Left
 func (e Either[A, B]) IsLeft() bool {
 	if e.side == isLeftSided {
@@ -152,19 +199,52 @@ func (e Either[A, B]) OrElse(fn func() Either[A, B]) Either[A, B] {
 // Map : map is right biased if the value is left it will return unchanged
 // and if the value is right mapper will be applied
 // and new either will return contain the right changed after applying the mapper
+// use MapRight to keep the type of the result
 func (e Either[A, B]) Map(mapper utils.Mapper[B, any]) Either[A, any] {
-	switch e.side {
-	case isLeftSided:
-		return Left[A, any](e.left)
-	default:
-		value := mapper(e.right)
-		return Right[A, any](value)
+	return MapRight(e, mapper)
+}
+
+// LeftMap applies mapper on the Left value and return the Right unchanged
+func (e Either[A, B]) LeftMap(mapper utils.Mapper[A, A]) Either[A, B] {
+	return MapLeft(e, mapper)
+}
+
+// Bimap applies leftMapper in case of Left and rightMapper in case of Right
+func (e Either[A, B]) Bimap(leftMapper utils.Mapper[A, A], rightMapper utils.Mapper[B, B]) Either[A, B] {
+	return BiMap(e, leftMapper, rightMapper)
+}
+
+// MapRight applies mapper on the Right value and return the Left unchanged
+// the result is created using Right, so nil values and pointers end up on the Left
+func MapRight[A, B, C any](either Either[A, B], mapper utils.Mapper[B, C]) Either[A, C] {
+	if either.IsLeft() {
+		return Left[A, C](either.left)
 	}
+	return Right[A, C](mapper(either.right))
+}
+
+// MapLeft applies mapper on the Left value and return the Right unchanged
+// e.g. adding context to errors
+//
+//	MapLeft(either, func(err error) error { return fmt.Errorf("loading config: %w", err) })
+func MapLeft[A, B, C any](either Either[A, B], mapper utils.Mapper[A, C]) Either[C, B] {
+	if either.IsLeft() {
+		return Left[C, B](mapper(either.left))
+	}
+	return Either[C, B]{right: either.right, side: either.side}
+}
+
+// BiMap applies leftMapper in case of Left and rightMapper in case of Right
+func BiMap[A, B, C, D any](either Either[A, B], leftMapper utils.Mapper[A, C], rightMapper utils.Mapper[B, D]) Either[C, D] {
+	if either.IsLeft() {
+		return Left[C, D](leftMapper(either.left))
+	}
+	return Right[C, D](rightMapper(either.right))
 }
 
 // Fold takes to fn f1: A => C , f2: B => C
 // and applies f1 in case off Left and f2 in case of Right
-// and returns the resulting value
+// and returns the resulting value, use FoldEither to keep the type of the result
 func (e Either[A, B]) Fold(a2c utils.Mapper[A, any], b2c utils.Mapper[B, any]) any {
 	return foldEither(e, a2c, b2c)
 }
@@ -189,6 +269,73 @@ func (e Either[A, B]) Exists(fn utils.Predicate[B]) bool {
 	return fn(e.right)
 }
 
+// ForAll return True if the value is Left or the Right value satisfies the predicate
+func (e Either[A, B]) ForAll(fn utils.Predicate[B]) bool {
+	if e.side == isLeftSided {
+		return true
+	}
+	return fn(e.right)
+}
+
+// Ensure return the Either unchanged if it is Left or the Right value satisfies the predicate
+// otherwise it returns Left(leftIfFalse)
+func (e Either[A, B]) Ensure(fn utils.Predicate[B], leftIfFalse A) Either[A, B] {
+	return e.FilterOrElse(fn, func(B) A {
+		return leftIfFalse
+	})
+}
+
+// FilterOrElse return the Either unchanged if it is Left or the Right value satisfies the predicate
+// otherwise it returns Left of applying orElse on the Right value
+func (e Either[A, B]) FilterOrElse(fn utils.Predicate[B], orElse utils.Mapper[B, A]) Either[A, B] {
+	if e.IsLeft() || fn(e.right) {
+		return e
+	}
+	return Left[A, B](orElse(e.right))
+}
+
+// Recover return Right of applying fn on the Left value, and the Either unchanged if it is Right
+// the recovered value is always Right even if it is nil or a pointer
+func (e Either[A, B]) Recover(fn utils.Mapper[A, B]) Either[A, B] {
+	if e.IsLeft() {
+		return RightUnchecked[A, B](fn(e.left))
+	}
+	return e
+}
+
+// RecoverWith return the result of applying fn on the Left value, and the Either unchanged if it is Right
+// unlike Recover fn can decide to fail again
+func (e Either[A, B]) RecoverWith(fn func(A) Either[A, B]) Either[A, B] {
+	if e.IsLeft() {
+		return fn(e.left)
+	}
+	return e
+}
+
+// OrElseMap Return Right if presented or the result of applying fn on the Left value if not
+func (e Either[A, B]) OrElseMap(fn utils.Mapper[A, B]) B {
+	if e.IsLeft() {
+		return fn(e.left)
+	}
+	return e.right
+}
+
+// Tap applies the side effect fn on the Right value and return the Either unchanged
+func (e Either[A, B]) Tap(fn func(B)) Either[A, B] {
+	if e.IsRight() {
+		fn(e.right)
+	}
+	return e
+}
+
+// TapLeft applies the side effect fn on the Left value and return the Either unchanged
+func (e Either[A, B]) TapLeft(fn func(A)) Either[A, B] {
+	if e.IsLeft() {
+		fn(e.left)
+	}
+	return e
+}
+
 // FlatMapperFn function definition that takes Right value and apply the function
 type FlatMapperFn[A, B, C any] func(value B) Either[A, C]
 
@@ -205,8 +352,7 @@ type EitherFlatMapFn[A, B, C any] func(value B) Either[A, C]
 //	Either[A, Either[B, C]] right -> left  => Either[B, any] left
 //	Either[A, B] left => Either [A, C] left
 //
-// this function will panic if you get more than 2 levels of Either
-// for such a case please use Flatten multiple times
+// for more than 2 levels of Either please use FlattenEither as many times as needed
 func (e Either[A, B]) FlatMap(fn EitherFlatMapFn[A, B, any]) Either[A, any] {
 	return EitherFlatMap(e, fn)
 }
@@ -221,8 +367,7 @@ func (e Either[A, B]) FlatMap(fn EitherFlatMapFn[A, B, any]) Either[A, any] {
 //	Either[A, Either[B, C]] right -> left  => Either[B, any] left
 //	Either[A, B] left => Either [A, C] left
 //
-// this function will panic if you get more than 2 levels of Either
-// for such a case please use Flatten multiple times
+// for more than 2 levels of Either please use FlattenEither as many times as needed
 func EitherFlatMap[A, B, C any](e Either[A, B], fn EitherFlatMapFn[A, B, C]) Either[A, C] {
 	// Evaluate left side
 	if e.IsLeft() {
@@ -231,6 +376,71 @@ func EitherFlatMap[A, B, C any](e Either[A, B], fn EitherFlatMapFn[A, B, C]) Eit
 	return fn(any(e.right).(B))
 }
 
+// TraverseEither applies fn on every element of lst and collect the Right results
+// it returns Right of all the results, or the first Left without applying fn on the rest of the elements
+// e.g.
+//
+//	TraverseEither(list.List[string]{"1", "2"}, parse) => Right([1, 2])
+//	TraverseEither(list.List[string]{"1", "x"}, parse) => Left(invalid syntax)
+func TraverseEither[L, A, B any](lst list.List[A], fn EitherFlatMapFn[L, A, B]) Either[L, list.List[B]] {
+	out := make(list.List[B], 0, lst.Size())
+	for _, elm := range lst {
+		either := fn(elm)
+		if either.IsLeft() {
+			return Left[L, list.List[B]](either.left)
+		}
+		out = append(out, either.right)
+	}
+	return RightUnchecked[L](out)
+}
+
+// SequenceEither converts a list of Eithers into an Either of list
+// it returns Right of all the Right values, or the first Left
+func SequenceEither[L, R any](eithers list.List[Either[L, R]]) Either[L, list.List[R]] {
+	return TraverseEither(eithers, func(either Either[L, R]) Either[L, R] {
+		return either
+	})
+}
+
+// PartitionEithers splits a list of Eithers into the Left values and the Right values keeping their order
+func PartitionEithers[L, R any](eithers list.List[Either[L, R]]) (lefts list.List[L], rights list.List[R]) {
+	lefts = make(list.List[L], 0)
+	rights = make(list.List[R], 0)
+	eithers.Foreach(func(either Either[L, R]) {
+		if either.IsLeft() {
+			lefts = append(lefts, either.left)
+			return
+		}
+		rights = append(rights, either.right)
+	})
+	return lefts, rights
+}
+
+// Lefts extracts the Left values of a list of Eithers
+func Lefts[L, R any](eithers list.List[Either[L, R]]) list.List[L] {
+	lefts, _ := PartitionEithers(eithers)
+	return lefts
+}
+
+// Rights extracts the Right values of a list of Eithers
+func Rights[L, R any](eithers list.List[Either[L, R]]) list.List[R] {
+	_, rights := PartitionEithers(eithers)
+	return rights
+}
+
+// FlattenEither :Flatten an Either[A, Either[A, B]] --> Either[A, B]
+// outer Left is returned as is, otherwise the inner Either is returned
+// deeper levels are flattened by applying FlattenEither multiple times
+// e.g.
+//
+//	FlattenEither(FlattenEither(Either[A, Either[A, Either[A, B]]])) => Either[A, B]
+func FlattenEither[A, B any](either Either[A, Either[A, B]]) Either[A, B] {
+	if either.IsLeft() {
+		return Left[A, B](either.left)
+	}
+	return either.right
+}
+
 // ToOption converts to Option if IsLeft => None[any]() , if IsRight then Some[B](value)
 func (e Either[A, B]) ToOption() Option[B] {
 	if e.IsRight() {
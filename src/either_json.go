@@ -0,0 +1,145 @@
+// Package src ...
+package src
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// EitherJSONLayout defines how Either is encoded as a JSON tagged union
+type EitherJSONLayout int
+
+const (
+	// EitherJSONKeyed encodes Either as {"left": value} or {"right": value}
+	EitherJSONKeyed EitherJSONLayout = iota
+	// EitherJSONTagged encodes Either as {"type": "left", "value": value} or {"type": "right", "value": value}
+	EitherJSONTagged
+)
+
+// ErrorInvalidEitherJSON if the JSON is not a valid Either tagged union
+var ErrorInvalidEitherJSON = errors.New("invalid Either JSON")
+
+// errorType is the reflection type of the error interface
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// eitherJSONTagged is the wire format of EitherJSONTagged
+type eitherJSONTagged struct {
+	Type  typeSide        `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+// TaggedEither wraps Either to be encoded using EitherJSONTagged, e.g. as a struct field
+// it is decoded like Either, so both layouts are accepted
+type TaggedEither[A, B any] struct {
+	Either[A, B]
+}
+
+// MarshalJSON implements json.Marshaler using EitherJSONKeyed
+// Left values of type error are encoded as their message
+func (e Either[A, B]) MarshalJSON() ([]byte, error) {
+	return MarshalEitherJSON(e, EitherJSONKeyed)
+}
+
+// MarshalJSON implements json.Marshaler using EitherJSONTagged
+func (e TaggedEither[A, B]) MarshalJSON() ([]byte, error) {
+	return MarshalEitherJSON(e.Either, EitherJSONTagged)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, both EitherJSONKeyed and EitherJSONTagged layouts are accepted
+// Left values of type error are decoded from their message using errors.New
+func (e *Either[A, B]) UnmarshalJSON(data []byte) error {
+	either, err := UnmarshalEitherJSON[A, B](data)
+	if err != nil {
+		return err
+	}
+	*e = either
+	return nil
+}
+
+// MarshalEitherJSON encodes the Either using the given layout
+func MarshalEitherJSON[A, B any](either Either[A, B], layout EitherJSONLayout) ([]byte, error) {
+	side, value, err := either.jsonValue()
+	if err != nil {
+		return nil, err
+	}
+	switch layout {
+	case EitherJSONKeyed:
+		return json.Marshal(map[typeSide]json.RawMessage{side: value})
+	case EitherJSONTagged:
+		return json.Marshal(eitherJSONTagged{Type: side, Value: value})
+	}
+	return nil, fmt.Errorf("%w: unknown layout %d", ErrorInvalidEitherJSON, layout)
+}
+
+// UnmarshalEitherJSON decodes an Either from data, the layout is detected from the keys
+func UnmarshalEitherJSON[A, B any](data []byte) (Either[A, B], error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return Either[A, B]{}, fmt.Errorf("%w: %v", ErrorInvalidEitherJSON, err)
+	}
+
+	var side typeSide
+	var value json.RawMessage
+	if _, ok := fields["type"]; ok {
+		var tagged eitherJSONTagged
+		if err := json.Unmarshal(data, &tagged); err != nil {
+			return Either[A, B]{}, fmt.Errorf("%w: %v", ErrorInvalidEitherJSON, err)
+		}
+		side, value = tagged.Type, tagged.Value
+	} else if len(fields) == 1 {
+		for key, raw := range fields {
+			side, value = typeSide(key), raw
+		}
+	}
+
+	switch side {
+	case isLeftSided:
+		left, err := decodeLeft[A](value)
+		if err != nil {
+			return Either[A, B]{}, err
+		}
+		return Left[A, B](left), nil
+	case isRightSided:
+		var right B
+		if err := json.Unmarshal(value, &right); err != nil {
+			return Either[A, B]{}, fmt.Errorf("%w: %v", ErrorInvalidEitherJSON, err)
+		}
+		return RightUnchecked[A, B](right), nil
+	}
+	return Either[A, B]{}, fmt.Errorf("%w: expected exactly one of %q or %q", ErrorInvalidEitherJSON, isLeftSided, isRightSided)
+}
+
+// jsonValue return the side and the encoded value of the Either
+func (e Either[A, B]) jsonValue() (typeSide, json.RawMessage, error) {
+	if e.IsRight() {
+		value, err := json.Marshal(e.right)
+		return isRightSided, value, err
+	}
+	if err, ok := any(e.left).(error); ok && reflect.TypeOf((*A)(nil)).Elem() == errorType {
+		value, marshalErr := json.Marshal(err.Error())
+		return isLeftSided, value, marshalErr
+	}
+	value, err := json.Marshal(e.left)
+	return isLeftSided, value, err
+}
+
+// decodeLeft decodes the Left value, errors are decoded from their message
+func decodeLeft[A any](value json.RawMessage) (A, error) {
+	var left A
+	if reflect.TypeOf((*A)(nil)).Elem() == errorType {
+		var msg *string
+		if err := json.Unmarshal(value, &msg); err != nil {
+			return left, fmt.Errorf("%w: %v", ErrorInvalidEitherJSON, err)
+		}
+		if msg != nil {
+			left = any(errors.New(*msg)).(A)
+		}
+		return left, nil
+	}
+	if err := json.Unmarshal(value, &left); err != nil {
+		return left, fmt.Errorf("%w: %v", ErrorInvalidEitherJSON, err)
+	}
+	return left, nil
+}
@@ -0,0 +1,176 @@
+package src
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type eitherJSONTestUser struct {
+	Name string `json:"name"`
+}
+
+func TestEither_MarshalJSON(t *testing.T) {
+	t.Run("right", func(t *testing.T) {
+		data, err := json.Marshal(Right[error, eitherJSONTestUser](eitherJSONTestUser{Name: "saddam"}))
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"right":{"name":"saddam"}}`, string(data))
+	})
+
+	t.Run("error left", func(t *testing.T) {
+		data, err := json.Marshal(Left[error, int](errors.New("missing value")))
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"left":"missing value"}`, string(data))
+	})
+
+	t.Run("nil error left", func(t *testing.T) {
+		data, err := json.Marshal(Left[error, int](nil))
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"left":null}`, string(data))
+	})
+
+	t.Run("struct left", func(t *testing.T) {
+		type problem struct {
+			Code int `json:"code"`
+		}
+		data, err := json.Marshal(Left[problem, int](problem{Code: 404}))
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"left":{"code":404}}`, string(data))
+	})
+
+	t.Run("nested in struct", func(t *testing.T) {
+		payload := struct {
+			Result Either[string, int] `json:"result"`
+		}{Result: Right[string, int](10)}
+		data, err := json.Marshal(payload)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"result":{"right":10}}`, string(data))
+	})
+}
+
+func TestMarshalEitherJSON(t *testing.T) {
+	t.Run("tagged right", func(t *testing.T) {
+		data, err := MarshalEitherJSON(Right[error, int](10), EitherJSONTagged)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"type":"right","value":10}`, string(data))
+	})
+
+	t.Run("tagged error left", func(t *testing.T) {
+		data, err := MarshalEitherJSON(Left[error, int](errors.New("missing value")), EitherJSONTagged)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"type":"left","value":"missing value"}`, string(data))
+	})
+
+	t.Run("unknown layout", func(t *testing.T) {
+		_, err := MarshalEitherJSON(Right[error, int](10), EitherJSONLayout(10))
+		assert.ErrorIs(t, err, ErrorInvalidEitherJSON)
+	})
+
+	t.Run("tagged wrapper", func(t *testing.T) {
+		type response struct {
+			Result TaggedEither[error, int] `json:"result"`
+		}
+		data, err := json.Marshal(response{Result: TaggedEither[error, int]{Right[error, int](10)}})
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"result":{"type":"right","value":10}}`, string(data))
+
+		var decoded response
+		assert.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, 10, decoded.Result.right)
+
+		// Either itself is always keyed
+		data, err = json.Marshal(Right[error, int](10))
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"right":10}`, string(data))
+	})
+}
+
+func TestEither_UnmarshalJSON(t *testing.T) {
+	tt := []struct {
+		name string
+		data string
+	}{
+		{name: "keyed", data: `{"right":{"name":"saddam"}}`},
+		{name: "tagged", data: `{"type":"right","value":{"name":"saddam"}}`},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name+" right", func(t *testing.T) {
+			var either Either[error, eitherJSONTestUser]
+			assert.NoError(t, json.Unmarshal([]byte(tc.data), &either))
+			assert.True(t, either.IsRight())
+			assert.Equal(t, eitherJSONTestUser{Name: "saddam"}, either.right)
+		})
+	}
+
+	t.Run("keyed error left", func(t *testing.T) {
+		var either Either[error, int]
+		assert.NoError(t, json.Unmarshal([]byte(`{"left":"missing value"}`), &either))
+		assert.True(t, either.IsLeft())
+		assert.EqualError(t, either.left, "missing value")
+	})
+
+	t.Run("tagged error left", func(t *testing.T) {
+		var either Either[error, int]
+		assert.NoError(t, json.Unmarshal([]byte(`{"type":"left","value":"missing value"}`), &either))
+		assert.True(t, either.IsLeft())
+		assert.EqualError(t, either.left, "missing value")
+	})
+
+	t.Run("null error left", func(t *testing.T) {
+		var either Either[error, int]
+		assert.NoError(t, json.Unmarshal([]byte(`{"left":null}`), &either))
+		assert.True(t, either.IsLeft())
+		assert.Nil(t, either.left)
+	})
+
+	t.Run("zero right value", func(t *testing.T) {
+		var either Either[string, int]
+		assert.NoError(t, json.Unmarshal([]byte(`{"right":0}`), &either))
+		assert.True(t, either.IsRight())
+		assert.Equal(t, 0, either.right)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		invalid := []string{
+			`[]`,
+			`{}`,
+			`{"left":"a","right":1}`,
+			`{"middle":1}`,
+			`{"right":"not a number"}`,
+			`{"left":1}`,
+			`{"type":"up","value":1}`,
+			`{"type":1}`,
+		}
+		for _, data := range invalid {
+			var either Either[error, int]
+			err := json.Unmarshal([]byte(data), &either)
+			assert.ErrorIs(t, err, ErrorInvalidEitherJSON, data)
+		}
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		for _, layout := range []EitherJSONLayout{EitherJSONKeyed, EitherJSONTagged} {
+			for _, in := range []Either[error, []string]{
+				Right[error, []string]([]string{"a", "b"}),
+				Left[error, []string](errors.New("missing value")),
+			} {
+				data, err := MarshalEitherJSON(in, layout)
+				assert.NoError(t, err)
+				out, err := UnmarshalEitherJSON[error, []string](data)
+				assert.NoError(t, err)
+				assert.Equal(t, in.String(), out.String())
+			}
+		}
+	})
+
+	t.Run("result", func(t *testing.T) {
+		data, err := json.Marshal(Ok(10))
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"right":10}`, string(data))
+
+		var result Result[int]
+		assert.NoError(t, json.Unmarshal([]byte(`{"left":"missing value"}`), &result))
+		assert.True(t, result.IsLeft())
+	})
+}
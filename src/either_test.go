@@ -2,7 +2,11 @@ package src
 
 import (
 	"errors"
+	"fmt"
+	"github.com/sghaida/fpv2/src/collections/list"
 	"github.com/stretchr/testify/assert"
+	"os"
+	"strconv"
 	"testing"
 )
 
@@ -368,3 +372,448 @@ func TestEither_ToOption(t *testing.T) {
 		assert.IsType(t, 10, none.value)
 	})
 }
+
+// nestEithers wraps every either in Right and adds a Left, to produce all the shapes of the next nesting level
+func nestEithers[A any](eithers []Either[error, A], left error) []Either[error, Either[error, A]] {
+	out := []Either[error, Either[error, A]]{Left[error, Either[error, A]](left)}
+	for _, either := range eithers {
+		out = append(out, Right[error, Either[error, A]](either))
+	}
+	return out
+}
+
+func TestFlattenEither(t *testing.T) {
+	errOuter := errors.New("outer")
+	errInner := errors.New("inner")
+
+	t.Run("2 levels", func(t *testing.T) {
+		assert.Equal(t, Right[error, int](10), FlattenEither(Right[error, Either[error, int]](Right[error, int](10))))
+
+		inner := FlattenEither(Right[error, Either[error, int]](Left[error, int](errInner)))
+		assert.True(t, inner.IsLeft())
+		assert.Equal(t, errInner, inner.left)
+
+		outer := FlattenEither(Left[error, Either[error, int]](errOuter))
+		assert.True(t, outer.IsLeft())
+		assert.Equal(t, errOuter, outer.left)
+	})
+
+	t.Run("arbitrary depth", func(t *testing.T) {
+		errs := []error{errors.New("level 1"), errors.New("level 2"), errors.New("level 3"), errors.New("level 4")}
+		depth1 := []Either[error, int]{Left[error, int](errs[0]), Right[error, int](10)}
+		depth2 := nestEithers(depth1, errs[1])
+		depth3 := nestEithers(depth2, errs[2])
+		depth4 := nestEithers(depth3, errs[3])
+
+		for _, either := range depth4 {
+			flat := FlattenEither(FlattenEither(FlattenEither(either)))
+			// the outermost Left wins
+			expected := Right[error, int](10)
+			switch {
+			case either.IsLeft():
+				expected = Left[error, int](errs[3])
+			case either.right.IsLeft():
+				expected = Left[error, int](errs[2])
+			case either.right.right.IsLeft():
+				expected = Left[error, int](errs[1])
+			case either.right.right.right.IsLeft():
+				expected = Left[error, int](errs[0])
+			}
+			assert.Equal(t, expected, flat)
+		}
+	})
+
+	t.Run("monad laws", func(t *testing.T) {
+		errOdd := errors.New("odd")
+		errNegative := errors.New("negative")
+		pure := func(value int) Either[error, int] { return Right[error, int](value) }
+		half := func(value int) Either[error, int] {
+			if value%2 != 0 {
+				return Left[error, int](errOdd)
+			}
+			return Right[error, int](value / 2)
+		}
+		positive := func(value int) Either[error, int] {
+			if value <= 0 {
+				return Left[error, int](errNegative)
+			}
+			return Right[error, int](value)
+		}
+
+		eithers := []Either[error, int]{Left[error, int](errOuter)}
+		for _, value := range []int{-4, -1, 1, 2, 4, 8} {
+			eithers = append(eithers, Right[error, int](value))
+			// left identity
+			assert.Equal(t, half(value), EitherFlatMap(pure(value), half))
+		}
+		for _, m := range eithers {
+			// right identity
+			assert.Equal(t, m, EitherFlatMap(m, pure))
+			// associativity
+			assert.Equal(t,
+				EitherFlatMap(EitherFlatMap(m, half), positive),
+				EitherFlatMap(m, func(v int) Either[error, int] { return EitherFlatMap(half(v), positive) }),
+			)
+		}
+	})
+
+	t.Run("flatten laws at arbitrary depth", func(t *testing.T) {
+		depth1 := []Either[error, int]{Left[error, int](errInner), Right[error, int](10)}
+		depth2 := nestEithers(depth1, errOuter)
+		depth3 := nestEithers(depth2, errOuter)
+
+		for _, either := range depth3 {
+			// Flatten . Flatten == Flatten . Map(Flatten)
+			mapped := EitherFlatMap(either, func(v Either[error, Either[error, int]]) Either[error, Either[error, int]] {
+				return Right[error, Either[error, int]](FlattenEither(v))
+			})
+			assert.Equal(t, FlattenEither(FlattenEither(either)), FlattenEither(mapped))
+			// Flatten . pure == id
+			assert.Equal(t, either, FlattenEither(Right[error, Either[error, Either[error, Either[error, int]]]](either)))
+		}
+	})
+}
+
+func TestFromError(t *testing.T) {
+	right := FromError(strconv.Atoi("10"))
+	assert.True(t, right.IsRight())
+	assert.Equal(t, 10, right.right)
+
+	left := FromError(strconv.Atoi("x"))
+	assert.True(t, left.IsLeft())
+	assert.ErrorIs(t, left.left, strconv.ErrSyntax)
+
+	zero := FromError(strconv.Atoi("0"))
+	assert.True(t, zero.IsRight())
+	assert.Equal(t, 0, zero.right)
+
+	file, err := os.Open(os.DevNull)
+	assert.NoError(t, err)
+	defer file.Close()
+	ptr := FromError(file, err)
+	assert.True(t, ptr.IsRight())
+	assert.Same(t, file, ptr.right)
+
+	var values []int
+	nilSlice := FromError(values, nil)
+	assert.True(t, nilSlice.IsRight())
+	assert.Nil(t, nilSlice.right)
+}
+
+func TestTryCatch(t *testing.T) {
+	t.Run("no panic", func(t *testing.T) {
+		either := TryCatch(func() int { return 10 })
+		assert.True(t, either.IsRight())
+		assert.Equal(t, 10, either.right)
+	})
+
+	t.Run("no panic with pointer", func(t *testing.T) {
+		value := 10
+		either := TryCatch(func() *int { return &value })
+		assert.True(t, either.IsRight())
+		assert.Same(t, &value, either.right)
+
+		nilMap := TryCatch(func() map[string]int { return nil })
+		assert.True(t, nilMap.IsRight())
+	})
+
+	t.Run("panic with error", func(t *testing.T) {
+		errBoom := errors.New("boom")
+		either := TryCatch(func() int { panic(errBoom) })
+		assert.True(t, either.IsLeft())
+		assert.ErrorIs(t, either.left, ErrorPanicRecovered)
+		assert.ErrorIs(t, either.left, errBoom)
+	})
+
+	t.Run("panic with value", func(t *testing.T) {
+		either := TryCatch(func() []int {
+			var values []int
+			return values[:1]
+		})
+		assert.True(t, either.IsLeft())
+		assert.ErrorIs(t, either.left, ErrorPanicRecovered)
+	})
+
+	t.Run("panic with string", func(t *testing.T) {
+		either := TryCatch(func() int { panic("boom") })
+		assert.True(t, either.IsLeft())
+		assert.EqualError(t, either.left, "panic recovered: boom")
+	})
+}
+
+func TestRight_NonErrorLeft(t *testing.T) {
+	t.Run("string left", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			either := Right[string, []int](nil)
+			assert.True(t, either.IsLeft())
+			assert.Equal(t, "", either.left)
+		})
+	})
+
+	t.Run("custom left", func(t *testing.T) {
+		type myErr struct{ code int }
+		assert.NotPanics(t, func() {
+			var ptr *int
+			either := Right[myErr, *int](ptr)
+			assert.True(t, either.IsLeft())
+			assert.Equal(t, myErr{}, either.left)
+		})
+	})
+
+	t.Run("map to nil", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			mapped := Right[string, int](10).Map(func(int) any { return nil })
+			assert.True(t, mapped.IsLeft())
+		})
+	})
+}
+
+func TestRightOrElse(t *testing.T) {
+	right := RightOrElse([]int{1}, "empty")
+	assert.True(t, right.IsRight())
+	assert.Equal(t, []int{1}, right.right)
+
+	left := RightOrElse([]int(nil), "empty")
+	assert.True(t, left.IsLeft())
+	assert.Equal(t, "empty", left.left)
+
+	value := 10
+	ptr := RightOrElse[string, *int](&value, "pointer")
+	assert.True(t, ptr.IsLeft())
+	assert.Equal(t, "pointer", ptr.left)
+}
+
+func TestRightUnchecked(t *testing.T) {
+	nilSlice := RightUnchecked[string, []int](nil)
+	assert.True(t, nilSlice.IsRight())
+	assert.Nil(t, nilSlice.right)
+
+	value := 10
+	ptr := RightUnchecked[string](&value)
+	assert.True(t, ptr.IsRight())
+	assert.Same(t, &value, ptr.right)
+}
+
+func TestMapRight(t *testing.T) {
+	right := MapRight(Right[error, int](10), strconv.Itoa)
+	assert.IsType(t, Either[error, string]{}, right)
+	assert.True(t, right.IsRight())
+	assert.Equal(t, "10", right.right)
+
+	errMissing := errors.New("missing")
+	left := MapRight(Left[error, int](errMissing), strconv.Itoa)
+	assert.True(t, left.IsLeft())
+	assert.Equal(t, errMissing, left.left)
+}
+
+func TestMapLeft(t *testing.T) {
+	wrap := func(err error) error { return fmt.Errorf("loading config: %w", err) }
+	errMissing := errors.New("missing")
+
+	left := MapLeft(Left[error, int](errMissing), wrap)
+	assert.True(t, left.IsLeft())
+	assert.EqualError(t, left.left, "loading config: missing")
+	assert.ErrorIs(t, left.left, errMissing)
+
+	right := MapLeft(Right[error, int](10), wrap)
+	assert.True(t, right.IsRight())
+	assert.Equal(t, 10, right.right)
+
+	code := MapLeft(Left[error, int](errMissing), func(err error) int { return 404 })
+	assert.IsType(t, Either[int, int]{}, code)
+	assert.Equal(t, 404, code.left)
+}
+
+func TestBiMap(t *testing.T) {
+	toCode := func(err error) int { return 500 }
+
+	right := BiMap(Right[error, int](10), toCode, strconv.Itoa)
+	assert.IsType(t, Either[int, string]{}, right)
+	assert.Equal(t, "10", right.right)
+
+	left := BiMap(Left[error, int](errors.New("missing")), toCode, strconv.Itoa)
+	assert.True(t, left.IsLeft())
+	assert.Equal(t, 500, left.left)
+}
+
+func TestEither_LeftMap(t *testing.T) {
+	wrap := func(err error) error { return fmt.Errorf("loading config: %w", err) }
+
+	left := Left[error, int](errors.New("missing")).LeftMap(wrap)
+	assert.EqualError(t, left.left, "loading config: missing")
+
+	right := Right[error, int](10).LeftMap(wrap)
+	assert.Equal(t, Right[error, int](10), right)
+}
+
+func TestEither_Bimap(t *testing.T) {
+	wrap := func(err error) error { return fmt.Errorf("loading config: %w", err) }
+	double := func(value int) int { return value * 2 }
+
+	assert.Equal(t, Right[error, int](20), Right[error, int](10).Bimap(wrap, double))
+
+	left := Left[error, int](errors.New("missing")).Bimap(wrap, double)
+	assert.EqualError(t, left.left, "loading config: missing")
+}
+
+func TestTraverseEither(t *testing.T) {
+	parse := func(value string) Either[error, int] {
+		return FromError(strconv.Atoi(value))
+	}
+
+	t.Run("all right", func(t *testing.T) {
+		result := TraverseEither(list.List[string]{"1", "2", "3"}, parse)
+		assert.True(t, result.IsRight())
+		assert.Equal(t, list.List[int]{1, 2, 3}, result.right)
+	})
+
+	t.Run("first left", func(t *testing.T) {
+		calls := 0
+		result := TraverseEither(list.List[string]{"1", "x", "y"}, func(value string) Either[error, int] {
+			calls++
+			return parse(value)
+		})
+		assert.True(t, result.IsLeft())
+		assert.ErrorContains(t, result.left, `"x"`)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("empty list", func(t *testing.T) {
+		result := TraverseEither(list.List[string]{}, parse)
+		assert.True(t, result.IsRight())
+		assert.Equal(t, 0, result.right.Size())
+	})
+
+	t.Run("composes with list ops", func(t *testing.T) {
+		result := TraverseEither(list.Map(list.List[int]{1, 2}, strconv.Itoa), parse)
+		assert.Equal(t, list.List[int]{1, 2}, result.right)
+	})
+}
+
+func TestSequenceEither(t *testing.T) {
+	errMissing := errors.New("missing")
+
+	right := SequenceEither([]Either[error, int]{Right[error, int](1), Right[error, int](2)})
+	assert.Equal(t, list.List[int]{1, 2}, right.right)
+
+	left := SequenceEither([]Either[error, int]{Right[error, int](1), Left[error, int](errMissing)})
+	assert.True(t, left.IsLeft())
+	assert.Equal(t, errMissing, left.left)
+}
+
+func TestPartitionEithers(t *testing.T) {
+	errA := errors.New("a")
+	errB := errors.New("b")
+	eithers := list.List[Either[error, int]]{
+		Right[error, int](1), Left[error, int](errA), Right[error, int](2), Left[error, int](errB),
+	}
+
+	lefts, rights := PartitionEithers(eithers)
+	assert.Equal(t, list.List[error]{errA, errB}, lefts)
+	assert.Equal(t, list.List[int]{1, 2}, rights)
+
+	assert.Equal(t, list.List[error]{errA, errB}, Lefts(eithers))
+	assert.Equal(t, list.List[int]{1, 2}, Rights(eithers))
+
+	lefts, rights = PartitionEithers(list.List[Either[error, int]]{})
+	assert.Equal(t, 0, lefts.Size())
+	assert.Equal(t, 0, rights.Size())
+}
+
+func TestEither_ForAll(t *testing.T) {
+	isEven := func(value int) bool { return value%2 == 0 }
+
+	assert.True(t, Right[error, int](10).ForAll(isEven))
+	assert.False(t, Right[error, int](11).ForAll(isEven))
+	assert.True(t, Left[error, int](errors.New("missing")).ForAll(isEven))
+}
+
+func TestEither_Ensure(t *testing.T) {
+	errNegative := errors.New("negative")
+	errMissing := errors.New("missing")
+	isPositive := func(value int) bool { return value > 0 }
+
+	assert.Equal(t, Right[error, int](10), Right[error, int](10).Ensure(isPositive, errNegative))
+
+	failed := Right[error, int](-10).Ensure(isPositive, errNegative)
+	assert.True(t, failed.IsLeft())
+	assert.Equal(t, errNegative, failed.left)
+
+	left := Left[error, int](errMissing).Ensure(isPositive, errNegative)
+	assert.Equal(t, errMissing, left.left)
+}
+
+func TestEither_FilterOrElse(t *testing.T) {
+	isPositive := func(value int) bool { return value > 0 }
+	orElse := func(value int) error { return fmt.Errorf("%d is not positive", value) }
+
+	assert.Equal(t, Right[error, int](10), Right[error, int](10).FilterOrElse(isPositive, orElse))
+
+	failed := Right[error, int](-10).FilterOrElse(isPositive, orElse)
+	assert.True(t, failed.IsLeft())
+	assert.EqualError(t, failed.left, "-10 is not positive")
+}
+
+func TestEither_Recover(t *testing.T) {
+	fallback := func(error) int { return 8080 }
+
+	recovered := Left[error, int](errors.New("missing")).Recover(fallback)
+	assert.True(t, recovered.IsRight())
+	assert.Equal(t, 8080, recovered.right)
+
+	assert.Equal(t, Right[error, int](10), Right[error, int](10).Recover(fallback))
+
+	nilSlice := Left[error, []int](errors.New("missing")).Recover(func(error) []int { return nil })
+	assert.True(t, nilSlice.IsRight())
+	assert.Nil(t, nilSlice.right)
+
+	value := 10
+	ptr := Left[string, *int]("boom").Recover(func(string) *int { return &value })
+	assert.True(t, ptr.IsRight())
+	assert.Same(t, &value, ptr.right)
+}
+
+func TestEither_RecoverWith(t *testing.T) {
+	errMissing := errors.New("missing")
+	errFatal := errors.New("fatal")
+	recoverMissing := func(err error) Either[error, int] {
+		if errors.Is(err, errMissing) {
+			return Right[error, int](8080)
+		}
+		return Left[error, int](err)
+	}
+
+	recovered := Left[error, int](errMissing).RecoverWith(recoverMissing)
+	assert.Equal(t, 8080, recovered.right)
+
+	failed := Left[error, int](errFatal).RecoverWith(recoverMissing)
+	assert.True(t, failed.IsLeft())
+	assert.Equal(t, errFatal, failed.left)
+
+	assert.Equal(t, Right[error, int](10), Right[error, int](10).RecoverWith(recoverMissing))
+}
+
+func TestEither_OrElseMap(t *testing.T) {
+	length := func(err error) int { return len(err.Error()) }
+
+	assert.Equal(t, 10, Right[error, int](10).OrElseMap(length))
+	assert.Equal(t, 7, Left[error, int](errors.New("missing")).OrElseMap(length))
+}
+
+func TestEither_Tap(t *testing.T) {
+	var rights []int
+	var lefts []error
+	errMissing := errors.New("missing")
+
+	right := Right[error, int](10).
+		Tap(func(value int) { rights = append(rights, value) }).
+		TapLeft(func(err error) { lefts = append(lefts, err) })
+	left := Left[error, int](errMissing).
+		Tap(func(value int) { rights = append(rights, value) }).
+		TapLeft(func(err error) { lefts = append(lefts, err) })
+
+	assert.Equal(t, Right[error, int](10), right)
+	assert.Equal(t, errMissing, left.left)
+	assert.Equal(t, []int{10}, rights)
+	assert.Equal(t, []error{errMissing}, lefts)
+}
@@ -0,0 +1,90 @@
+// Package src ...
+package src
+
+import (
+	"fmt"
+	"log/slog"
+	"reflect"
+	"strings"
+)
+
+// GoString return Go syntax representation of the Option, e.g. src.Some[int](10) or src.None[int]()
+func (o Option[A]) GoString() string {
+	if o.IsNone() {
+		return fmt.Sprintf("src.None[%s]()", typeName[A]())
+	}
+	return fmt.Sprintf("src.Some[%s](%#v)", typeName[A](), o.value)
+}
+
+// Format implements fmt.Formatter
+// %#v uses GoString, %s uses String, and any other verb is applied on the wrapped value, so %v prints Some[10]
+// and %+v prints the wrapped structs with their field names, the verbs that the wrapped value can't handle fall back to %v
+func (o Option[A]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		_, _ = fmt.Fprint(f, o.GoString())
+	case verb == 's':
+		_, _ = fmt.Fprintf(f, fmt.FormatString(f, verb), o.String())
+	case o.IsNone():
+		_, _ = fmt.Fprint(f, NoneType)
+	default:
+		_, _ = fmt.Fprintf(f, "Some[%s]", formatValue(f, verb, o.value))
+	}
+}
+
+// LogValue implements slog.LogValuer, the variant and the wrapped value are logged as separate attributes
+func (o Option[A]) LogValue() slog.Value {
+	if o.IsNone() {
+		return slog.GroupValue(slog.String("variant", string(NoneType)))
+	}
+	return slog.GroupValue(slog.String("variant", string(SomeType)), slog.Any("value", o.value))
+}
+
+// GoString return Go syntax representation of the Either, e.g. src.Right[error, int](10)
+func (e Either[A, B]) GoString() string {
+	if e.IsLeft() {
+		return fmt.Sprintf("src.Left[%s, %s](%#v)", typeName[A](), typeName[B](), e.left)
+	}
+	return fmt.Sprintf("src.Right[%s, %s](%#v)", typeName[A](), typeName[B](), e.right)
+}
+
+// Format implements fmt.Formatter
+// %#v uses GoString, %s uses String, and any other verb is applied on the value of the presented side,
+// so %v prints Right(10) and %+v prints the wrapped structs with their field names,
+// the verbs that the value can't handle fall back to %v
+func (e Either[A, B]) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		_, _ = fmt.Fprint(f, e.GoString())
+	case verb == 's':
+		_, _ = fmt.Fprintf(f, fmt.FormatString(f, verb), e.String())
+	case e.IsLeft():
+		_, _ = fmt.Fprintf(f, "Left(%s)", formatValue(f, verb, e.left))
+	default:
+		_, _ = fmt.Fprintf(f, "Right(%s)", formatValue(f, verb, e.right))
+	}
+}
+
+// LogValue implements slog.LogValuer, the side and its value are logged as separate attributes
+func (e Either[A, B]) LogValue() slog.Value {
+	if e.IsLeft() {
+		return slog.GroupValue(slog.String("variant", "Left"), slog.Any("value", e.left))
+	}
+	return slog.GroupValue(slog.String("variant", "Right"), slog.Any("value", e.right))
+}
+
+// formatValue applies the verb and the flags of f on value, and falls back to %v if value or any of its fields
+// doesn't support the verb, which fmt reports using %!verb(type=value)
+func formatValue(f fmt.State, verb rune, value any) string {
+	formatted := fmt.Sprintf(fmt.FormatString(f, verb), value)
+	plain := fmt.Sprintf("%v", value)
+	if strings.Count(formatted, "%!") > strings.Count(plain, "%!") {
+		return plain
+	}
+	return formatted
+}
+
+// typeName return the name of type A including interface types such as error
+func typeName[A any]() string {
+	return reflect.TypeOf((*A)(nil)).Elem().String()
+}
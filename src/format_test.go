@@ -0,0 +1,127 @@
+package src
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"log/slog"
+	"testing"
+)
+
+type formatTestUser struct {
+	Name string
+	Age  int
+}
+
+func TestEither_String(t *testing.T) {
+	assert.Equal(t, "Right(10)", Right[error, int](10).String())
+	assert.Equal(t, "Left(missing)", Left[error, int](errors.New("missing")).String())
+}
+
+func TestOption_GoString(t *testing.T) {
+	assert.Equal(t, "src.Some[int](10)", NewOptional(10).GoString())
+	assert.Equal(t, `src.Some[string]("saddam")`, NewOptional("saddam").GoString())
+	assert.Equal(t, "src.None[[]string]()", None[[]string]().GoString())
+}
+
+func TestEither_GoString(t *testing.T) {
+	assert.Equal(t, "src.Right[error, int](10)", Right[error, int](10).GoString())
+	assert.Equal(t, `src.Left[string, int]("missing")`, Left[string, int]("missing").GoString())
+}
+
+func TestOption_Format(t *testing.T) {
+	user := formatTestUser{Name: "saddam", Age: 42}
+	tt := []struct {
+		name     string
+		format   string
+		value    any
+		expected string
+	}{
+		{name: "v some", format: "%v", value: NewOptional(10), expected: "Some[10]"},
+		{name: "v none", format: "%v", value: None[int](), expected: "None"},
+		{name: "s some", format: "%s", value: NewOptional("saddam"), expected: "Some[saddam]"},
+		{name: "s some int", format: "%s", value: NewOptional(10), expected: "Some[10]"},
+		{name: "s some struct", format: "%s", value: NewOptional(user), expected: "Some[{saddam 42}]"},
+		{name: "s none", format: "%s", value: None[int](), expected: "None"},
+		{name: "d some string", format: "%d", value: NewOptional("saddam"), expected: "Some[saddam]"},
+		{name: "d some struct", format: "%d", value: NewOptional(user), expected: "Some[{saddam 42}]"},
+		{name: "d some percent", format: "%d", value: NewOptional("100%!"), expected: "Some[100%!]"},
+		{name: "s width", format: "%10s", value: NewOptional(10), expected: "  Some[10]"},
+		{name: "s left aligned", format: "%-10s|", value: None[int](), expected: "None      |"},
+		{name: "plus v struct", format: "%+v", value: NewOptional(user), expected: "Some[{Name:saddam Age:42}]"},
+		{name: "plus v none", format: "%+v", value: None[formatTestUser](), expected: "None"},
+		{name: "sharp v some", format: "%#v", value: NewOptional(10), expected: "src.Some[int](10)"},
+		{name: "sharp v none", format: "%#v", value: None[int](), expected: "src.None[int]()"},
+		{name: "d some", format: "%03d", value: NewOptional(7), expected: "Some[007]"},
+		{name: "q some", format: "%q", value: NewOptional("saddam"), expected: `Some["saddam"]`},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, fmt.Sprintf(tc.format, tc.value))
+		})
+	}
+}
+
+func TestEither_Format(t *testing.T) {
+	user := formatTestUser{Name: "saddam", Age: 42}
+	tt := []struct {
+		name     string
+		format   string
+		value    any
+		expected string
+	}{
+		{name: "v right", format: "%v", value: Right[error, int](10), expected: "Right(10)"},
+		{name: "v left", format: "%v", value: Left[error, int](errors.New("missing")), expected: "Left(missing)"},
+		{name: "plus v struct", format: "%+v", value: Right[error, formatTestUser](user), expected: "Right({Name:saddam Age:42})"},
+		{name: "sharp v right", format: "%#v", value: Right[string, int](10), expected: "src.Right[string, int](10)"},
+		{name: "sharp v left", format: "%#v", value: Left[string, int]("missing"), expected: `src.Left[string, int]("missing")`},
+		{name: "d right", format: "%03d", value: Right[string, int](7), expected: "Right(007)"},
+		{name: "q left", format: "%q", value: Left[string, int]("missing"), expected: `Left("missing")`},
+		{name: "s right int", format: "%s", value: Right[error, int](10), expected: "Right(10)"},
+		{name: "s right struct", format: "%s", value: Right[error, formatTestUser](user), expected: "Right({saddam 42})"},
+		{name: "s left", format: "%s", value: Left[error, int](errors.New("missing")), expected: "Left(missing)"},
+		{name: "d left string", format: "%d", value: Left[string, int]("missing"), expected: "Left(missing)"},
+		{name: "d right struct", format: "%d", value: Right[error, formatTestUser](user), expected: "Right({saddam 42})"},
+		{name: "s width", format: "%11s", value: Right[error, int](10), expected: "  Right(10)"},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, fmt.Sprintf(tc.format, tc.value))
+		})
+	}
+}
+
+func TestLogValue(t *testing.T) {
+	logRecord := func(t *testing.T, value any) map[string]any {
+		t.Helper()
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&buf, nil))
+		logger.Info("test", "result", value)
+
+		var record map[string]any
+		assert.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+		return record["result"].(map[string]any)
+	}
+
+	t.Run("some", func(t *testing.T) {
+		result := logRecord(t, NewOptional(10))
+		assert.Equal(t, map[string]any{"variant": "Some", "value": float64(10)}, result)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		result := logRecord(t, None[int]())
+		assert.Equal(t, map[string]any{"variant": "None"}, result)
+	})
+
+	t.Run("right", func(t *testing.T) {
+		result := logRecord(t, Right[error, string]("saddam"))
+		assert.Equal(t, map[string]any{"variant": "Right", "value": "saddam"}, result)
+	})
+
+	t.Run("left", func(t *testing.T) {
+		result := logRecord(t, Left[error, string](errors.New("missing")))
+		assert.Equal(t, map[string]any{"variant": "Left", "value": "missing"}, result)
+	})
+}
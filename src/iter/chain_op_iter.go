@@ -0,0 +1,64 @@
+// Package iter ...
+package iter
+
+type chainOpIter[A any] struct {
+	iters []Iter[A]
+}
+
+// Chain lazily concatenates the Iters, the elements of each Iter are returned after the previous one is consumed
+func Chain[A any](iters ...Iter[A]) Iter[A] {
+	return &chainOpIter[A]{iters: iters}
+}
+
+// HasNext check if there is next element, by moving to the next non-empty Iter
+func (ci *chainOpIter[A]) HasNext() bool {
+	for len(ci.iters) > 0 {
+		if ci.iters[0].HasNext() {
+			return true
+		}
+		ci.iters = ci.iters[1:]
+	}
+	return false
+}
+
+// Next return the next element if available
+func (ci *chainOpIter[A]) Next() A {
+	if !ci.HasNext() {
+		var zero A
+		return zero
+	}
+	return ci.iters[0].Next()
+}
+
+// Count return the number of the remaining elements and move to the end of the iter
+// or SizeInfinite if any of the Iters is infinite
+func (ci *chainOpIter[A]) Count() int {
+	if ci.Size() == SizeInfinite {
+		return SizeInfinite
+	}
+	count := 0
+	for _, iter := range ci.iters {
+		count += iter.Count()
+	}
+	ci.iters = nil
+	return count
+}
+
+// Size return the sum of the sizes of the Iters,
+// or SizeInfinite if any of them is infinite otherwise SizeUnknown if the size of any of them is unknown
+func (ci *chainOpIter[A]) Size() int {
+	size := 0
+	for _, iter := range ci.iters {
+		switch s := iter.Size(); s {
+		case SizeInfinite:
+			return SizeInfinite
+		case SizeUnknown:
+			size = SizeUnknown
+		default:
+			if size != SizeUnknown {
+				size += s
+			}
+		}
+	}
+	return size
+}
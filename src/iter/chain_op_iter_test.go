@@ -0,0 +1,37 @@
+package iter
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestChain(t *testing.T) {
+	t.Run("concatenates the iters", func(t *testing.T) {
+		chained := Chain[int](FromSlice([]int{1, 2}), Empty[int](), FromSlice([]int{3}))
+		assert.Equal(t, 3, chained.Size())
+		assert.Equal(t, []int{1, 2, 3}, collect(chained))
+		assert.Equal(t, 0, chained.Size())
+		assert.Equal(t, 0, chained.Next())
+	})
+
+	t.Run("count", func(t *testing.T) {
+		chained := Chain[int](FromSlice([]int{1, 2}), FromSlice([]int{3, 4}))
+		chained.Next()
+		assert.Equal(t, 3, chained.Count())
+		assert.False(t, chained.HasNext())
+	})
+
+	t.Run("unknown size", func(t *testing.T) {
+		flat := FlatMap[int, int](FromSlice([]int{1}), func(i int) Iter[int] {
+			return FromSlice([]int{i})
+		})
+		chained := Chain[int](FromSlice([]int{1}), flat)
+		assert.Equal(t, SizeUnknown, chained.Size())
+	})
+
+	t.Run("no iters", func(t *testing.T) {
+		chained := Chain[int]()
+		assert.False(t, chained.HasNext())
+		assert.Equal(t, 0, chained.Size())
+	})
+}
@@ -0,0 +1,111 @@
+// Package iter ...
+package iter
+
+import "context"
+
+type chanIter[A any] struct {
+	ctx     context.Context
+	from    <-chan A
+	next    A
+	fetched bool
+	done    bool
+}
+
+// FromChan lazily converts a channel to an Iter, HasNext blocks until a value is received
+// the Iter ends when the channel is closed or the context is cancelled, no goroutine is started
+func FromChan[A any](ctx context.Context, ch <-chan A) Iter[A] {
+	return &chanIter[A]{
+		ctx:  ctx,
+		from: ch,
+	}
+}
+
+// ToChan starts a goroutine that sends the elements of the Iter to the returned channel
+// the channel is closed once the Iter is exhausted or the context is cancelled, so that the goroutine won't leak.
+// please note that an element is pulled from the Iter before it can be sent, so on cancellation
+// at most one element that was already pulled from the Iter is dropped, the rest of the Iter is left untouched
+func ToChan[A any](ctx context.Context, iter Iter[A], buffer int) <-chan A {
+	if buffer < 0 {
+		buffer = 0
+	}
+	ch := make(chan A, buffer)
+	go func() {
+		defer close(ch)
+		for ctx.Err() == nil && iter.HasNext() {
+			value := iter.Next()
+			select {
+			case ch <- value:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// Drain receives and discards the values of the channel until it is closed or the context is cancelled
+// and return the number of the discarded values, it is used to unblock the senders of an abandoned channel
+func Drain[A any](ctx context.Context, ch <-chan A) int {
+	count := 0
+	for {
+		select {
+		case _, ok := <-ch:
+			if !ok {
+				return count
+			}
+			count++
+		case <-ctx.Done():
+			return count
+		}
+	}
+}
+
+// HasNext check if there is next element, by receiving it from the channel
+func (ci *chanIter[A]) HasNext() bool {
+	if ci.fetched {
+		return true
+	}
+	if ci.done || ci.ctx.Err() != nil {
+		ci.done = true
+		return false
+	}
+	select {
+	case value, ok := <-ci.from:
+		if !ok {
+			ci.done = true
+			return false
+		}
+		ci.next, ci.fetched = value, true
+		return true
+	case <-ci.ctx.Done():
+		ci.done = true
+		return false
+	}
+}
+
+// Next return the next element if available
+func (ci *chanIter[A]) Next() A {
+	var zero A
+	if !ci.HasNext() {
+		return zero
+	}
+	value := ci.next
+	ci.next, ci.fetched = zero, false
+	return value
+}
+
+// Count return the number of the remaining elements and move to the end of the iter
+// it blocks until the channel is closed or the context is cancelled
+func (ci *chanIter[A]) Count() int {
+	count := 0
+	for ci.HasNext() {
+		ci.Next()
+		count++
+	}
+	return count
+}
+
+// Size return SizeUnknown, since the number of values of a channel is known only after it is closed
+func (ci *chanIter[A]) Size() int {
+	return SizeUnknown
+}
@@ -0,0 +1,115 @@
+package iter
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// verifyNoLeaks fails the test if the number of goroutines doesn't get back to what it was before the test
+func verifyNoLeaks(t *testing.T) {
+	t.Helper()
+	before := runtime.NumGoroutine()
+	t.Cleanup(func() {
+		t.Helper()
+		current := before
+		for attempt := 0; attempt < 50; attempt++ {
+			if current = runtime.NumGoroutine(); current <= before {
+				return
+			}
+			runtime.Gosched()
+			time.Sleep(10 * time.Millisecond)
+		}
+		buf := make([]byte, 1<<16)
+		t.Errorf("found %d leaked goroutines\n%s", current-before, buf[:runtime.Stack(buf, true)])
+	})
+}
+
+func TestFromChan(t *testing.T) {
+	t.Run("ends when the channel is closed", func(t *testing.T) {
+		verifyNoLeaks(t)
+		ch := make(chan int, 3)
+		ch <- 1
+		ch <- 2
+		ch <- 3
+		close(ch)
+		iter := FromChan(context.Background(), ch)
+		assert.Equal(t, SizeUnknown, iter.Size())
+		assert.True(t, iter.HasNext())
+		assert.Equal(t, 1, iter.Next())
+		assert.Equal(t, 2, iter.Count())
+		assert.False(t, iter.HasNext())
+		assert.Equal(t, 0, iter.Next())
+	})
+
+	t.Run("ends when the context is cancelled", func(t *testing.T) {
+		verifyNoLeaks(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		ch := make(chan int)
+		iter := FromChan(ctx, ch)
+		time.AfterFunc(10*time.Millisecond, cancel)
+		assert.False(t, iter.HasNext())
+		assert.Equal(t, 0, iter.Count())
+	})
+}
+
+func TestToChan(t *testing.T) {
+	t.Run("sends all the elements", func(t *testing.T) {
+		verifyNoLeaks(t)
+		ch := ToChan[int](context.Background(), FromSlice([]int{1, 2, 3}), 1)
+		var values []int
+		for value := range ch {
+			values = append(values, value)
+		}
+		assert.Equal(t, []int{1, 2, 3}, values)
+	})
+
+	t.Run("stops when the context is cancelled", func(t *testing.T) {
+		verifyNoLeaks(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		source := FromSlice([]int{1, 2, 3, 4, 5})
+		pulled := make(chan int, 5)
+		ch := ToChan(ctx, Inspect[int](source, func(i int) { pulled <- i }), 0)
+		assert.Equal(t, 1, <-ch)
+		// wait until 2 is pulled and pending, then cancel
+		assert.Equal(t, 1, <-pulled)
+		assert.Equal(t, 2, <-pulled)
+		cancel()
+		// 2 is either delivered before the channel is closed or dropped, nothing else is pulled
+		delivered := collect(FromChan(context.Background(), ch))
+		assert.LessOrEqual(t, len(delivered), 1)
+		if len(delivered) == 1 {
+			assert.Equal(t, 2, delivered[0])
+		}
+		assert.Equal(t, []int{3, 4, 5}, source.ToSlice())
+		assert.Empty(t, pulled)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		verifyNoLeaks(t)
+		ctx := context.Background()
+		iter := FromChan(ctx, ToChan[int](ctx, FromSlice([]int{1, 2, 3}), 0))
+		assert.Equal(t, []int{1, 2, 3}, collect(iter))
+	})
+}
+
+func TestDrain(t *testing.T) {
+	t.Run("drains until closed", func(t *testing.T) {
+		verifyNoLeaks(t)
+		ch := ToChan[int](context.Background(), FromSlice([]int{1, 2, 3}), 0)
+		assert.Equal(t, 3, Drain(context.Background(), ch))
+	})
+
+	t.Run("stops when the context is cancelled", func(t *testing.T) {
+		verifyNoLeaks(t)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		ch := make(chan int, 2)
+		ch <- 1
+		ch <- 2
+		assert.LessOrEqual(t, Drain(ctx, ch), 2)
+		assert.Error(t, ctx.Err())
+	})
+}
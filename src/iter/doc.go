@@ -2,4 +2,6 @@
 // Basic Iter, SliceIter, RangeIter, EmptyIter
 // all Iter types support the following operations
 // Next, HasNext, Count, Size
+// the generators such as Generate, Iterate, Repeat and Cycle are infinite
+// their Size and Count return SizeInfinite instead of hanging
 package iter
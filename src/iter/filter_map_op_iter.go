@@ -0,0 +1,87 @@
+// Package iter ...
+package iter
+
+// filterMapOpIter lazily maps the elements of an Iter and skips the ones that fn rejects
+// it looks ahead by one element, so HasNext may consume elements of the underlying Iter
+type filterMapOpIter[A, B any] struct {
+	from    Iter[A]
+	fn      func(A) (B, bool)
+	next    B
+	fetched bool
+}
+
+// filterMap create a lazy Iter that holds fn(value) for every value of iter that fn accepts
+func filterMap[A, B any](iter Iter[A], fn func(A) (B, bool)) Iter[B] {
+	return &filterMapOpIter[A, B]{
+		from: iter,
+		fn:   fn,
+	}
+}
+
+// Filter lazily creates an Iter of the elements that satisfy the predicate
+// unlike SliceIter.Filter no intermediate slice is created
+func Filter[A any](iter Iter[A], fn func(A) bool) Iter[A] {
+	return filterMap(iter, func(value A) (A, bool) {
+		return value, fn(value)
+	})
+}
+
+// DropWhile lazily skips the leading elements that satisfy the predicate and return the rest of them
+func DropWhile[A any](iter Iter[A], fn func(A) bool) Iter[A] {
+	dropping := true
+	return filterMap(iter, func(value A) (A, bool) {
+		dropping = dropping && fn(value)
+		return value, !dropping
+	})
+}
+
+// HasNext check if there is next element, by looking for the next accepted element
+func (fmi *filterMapOpIter[A, B]) HasNext() bool {
+	for !fmi.fetched && fmi.from.HasNext() {
+		fmi.next, fmi.fetched = fmi.fn(fmi.from.Next())
+	}
+	return fmi.fetched
+}
+
+// Next return the next accepted element if available
+// please note that the default value of type B could be nil
+func (fmi *filterMapOpIter[A, B]) Next() B {
+	var zero B
+	if !fmi.HasNext() {
+		return zero
+	}
+	value := fmi.next
+	fmi.next, fmi.fetched = zero, false
+	return value
+}
+
+// Count return the number of the remaining accepted elements and move to the end of the iter
+// or SizeInfinite if the underlying iter is infinite
+func (fmi *filterMapOpIter[A, B]) Count() int {
+	if fmi.from.Size() == SizeInfinite {
+		return SizeInfinite
+	}
+	count := 0
+	for fmi.HasNext() {
+		fmi.Next()
+		count++
+	}
+	return count
+}
+
+// Size return SizeUnknown, since the elements are filtered lazily, or 0 once the underlying iter is exhausted
+func (fmi *filterMapOpIter[A, B]) Size() int {
+	if !fmi.fetched && fmi.from.Size() == 0 {
+		return 0
+	}
+	return SizeUnknown
+}
+
+// SizeHint return the size of the underlying iter, which is an upper bound of the number of elements
+func (fmi *filterMapOpIter[A, B]) SizeHint() int {
+	size := SizeHint(fmi.from)
+	if fmi.fetched && size >= 0 {
+		return size + 1
+	}
+	return size
+}
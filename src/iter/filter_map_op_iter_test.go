@@ -0,0 +1,71 @@
+package iter
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFilter(t *testing.T) {
+	t.Run("keeps the matching elements", func(t *testing.T) {
+		even := Filter[int](FromSlice([]int{1, 2, 3, 4, 5, 6}), func(i int) bool {
+			return i%2 == 0
+		})
+		assert.Equal(t, SizeUnknown, even.Size())
+		assert.Equal(t, 6, SizeHint(even))
+		assert.True(t, even.HasNext())
+		assert.Equal(t, 2, even.Next())
+		assert.Equal(t, 2, even.Count())
+		assert.False(t, even.HasNext())
+		assert.Equal(t, 0, even.Size())
+		assert.Equal(t, 0, even.Next())
+	})
+
+	t.Run("no matching elements", func(t *testing.T) {
+		none := Filter[int](FromSlice([]int{1, 3, 5}), func(i int) bool {
+			return i%2 == 0
+		})
+		assert.Equal(t, SizeUnknown, none.Size())
+		assert.False(t, none.HasNext())
+		assert.Equal(t, 0, none.Size())
+	})
+
+	t.Run("is lazy", func(t *testing.T) {
+		calls := 0
+		filtered := Filter[int](FromSlice([]int{1, 2, 3, 4}), func(i int) bool {
+			calls++
+			return i > 1
+		})
+		assert.Equal(t, 0, calls)
+		assert.Equal(t, 2, filtered.Next())
+		assert.Equal(t, 2, calls)
+	})
+}
+
+func TestDropWhile(t *testing.T) {
+	in := []int{1, 2, 3, 1, 2}
+	rest := DropWhile[int](FromSlice(in), func(i int) bool {
+		return i < 3
+	})
+	assert.Equal(t, SizeUnknown, rest.Size())
+	assert.Equal(t, []int{3, 1, 2}, collect(rest))
+	assert.Equal(t, 0, rest.Size())
+
+	all := DropWhile[int](FromSlice(in), func(i int) bool {
+		return true
+	})
+	assert.False(t, all.HasNext())
+
+	none := DropWhile[int](FromSlice(in), func(i int) bool {
+		return false
+	})
+	assert.Equal(t, in, collect(none))
+}
+
+// collect consumes iter and return its elements
+func collect[A any](iter Iter[A]) []A {
+	var values []A
+	for iter.HasNext() {
+		values = append(values, iter.Next())
+	}
+	return values
+}
@@ -0,0 +1,55 @@
+// Package iter ...
+package iter
+
+type flatMapOpIter[A, B any] struct {
+	from    Iter[A]
+	fn      func(A) Iter[B]
+	current Iter[B]
+}
+
+// FlatMap lazily applies fn on every element and return the elements of the resulting Iters in order
+func FlatMap[A, B any](iter Iter[A], fn func(A) Iter[B]) Iter[B] {
+	return &flatMapOpIter[A, B]{
+		from:    iter,
+		fn:      fn,
+		current: Empty[B](),
+	}
+}
+
+// HasNext check if there is next element, by moving to the next non-empty inner Iter
+func (fmi *flatMapOpIter[A, B]) HasNext() bool {
+	for !fmi.current.HasNext() {
+		if !fmi.from.HasNext() {
+			return false
+		}
+		fmi.current = fmi.fn(fmi.from.Next())
+	}
+	return true
+}
+
+// Next return the next element if available
+func (fmi *flatMapOpIter[A, B]) Next() B {
+	if !fmi.HasNext() {
+		var zero B
+		return zero
+	}
+	return fmi.current.Next()
+}
+
+// Count return the number of the remaining elements and move to the end of the iter
+// or SizeInfinite if the underlying iter is infinite
+func (fmi *flatMapOpIter[A, B]) Count() int {
+	if fmi.from.Size() == SizeInfinite {
+		return SizeInfinite
+	}
+	count := 0
+	for fmi.HasNext() {
+		count += fmi.current.Count()
+	}
+	return count
+}
+
+// Size return SizeUnknown, since the size of the inner Iters is known only after applying fn
+func (fmi *flatMapOpIter[A, B]) Size() int {
+	return SizeUnknown
+}
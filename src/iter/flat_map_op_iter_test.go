@@ -0,0 +1,41 @@
+package iter
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFlatMap(t *testing.T) {
+	repeat := func(i int) Iter[int] {
+		values := make([]int, i)
+		for idx := range values {
+			values[idx] = i
+		}
+		return FromSlice(values)
+	}
+
+	t.Run("flattens the inner iters", func(t *testing.T) {
+		flat := FlatMap[int, int](FromSlice([]int{1, 0, 2, 3}), repeat)
+		assert.Equal(t, SizeUnknown, flat.Size())
+		assert.Equal(t, []int{1, 2, 2, 3, 3, 3}, collect(flat))
+		assert.Equal(t, 0, flat.Next())
+	})
+
+	t.Run("count", func(t *testing.T) {
+		flat := FlatMap[int, int](FromSlice([]int{1, 2, 3}), repeat)
+		flat.Next()
+		assert.Equal(t, 5, flat.Count())
+		assert.False(t, flat.HasNext())
+	})
+
+	t.Run("is lazy", func(t *testing.T) {
+		calls := 0
+		flat := FlatMap[int, int](FromSlice([]int{1, 2, 3}), func(i int) Iter[int] {
+			calls++
+			return repeat(i)
+		})
+		assert.Equal(t, 0, calls)
+		assert.Equal(t, 1, flat.Next())
+		assert.Equal(t, 1, calls)
+	})
+}
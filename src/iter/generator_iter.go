@@ -0,0 +1,248 @@
+// Package iter ...
+package iter
+
+import "github.com/sghaida/fpv2/src"
+
+type generateIter[A any] struct {
+	fn func() A
+}
+
+type cycleIter[A any] struct {
+	from     Iter[A]
+	elements []A
+	pos      int
+}
+
+type unfoldIter[A, S any] struct {
+	state   S
+	fn      func(S) src.Option[src.Pair[A, S]]
+	next    A
+	fetched bool
+	done    bool
+}
+
+type takeIter[A any] struct {
+	from Iter[A]
+	n    int
+}
+
+// Generate create an infinite Iter that calls fn for every element
+func Generate[A any](fn func() A) Iter[A] {
+	return &generateIter[A]{fn: fn}
+}
+
+// Iterate create an infinite Iter of seed, fn(seed), fn(fn(seed)) ...
+// fn is applied lazily when the next element is requested
+func Iterate[A any](seed A, fn func(A) A) Iter[A] {
+	current, started := seed, false
+	return Generate(func() A {
+		if started {
+			current = fn(current)
+		}
+		started = true
+		return current
+	})
+}
+
+// Repeat create an infinite Iter that always return value
+func Repeat[A any](value A) Iter[A] {
+	return Generate(func() A {
+		return value
+	})
+}
+
+// Cycle create an Iter that repeats the elements of iter forever,
+// the elements are buffered while iter is consumed for the first time, it is empty if iter is empty
+func Cycle[A any](iter Iter[A]) Iter[A] {
+	return &cycleIter[A]{from: iter}
+}
+
+// Unfold create an Iter from a state, fn return the next element with the next state, or None to end the Iter
+// e.g.
+//
+//	Unfold(1, func(s int) src.Option[src.Pair[int, int]] {
+//		if s > 100 {
+//			return src.None[src.Pair[int, int]]()
+//		}
+//		return src.NewOptional(src.NewPair(s, s*2))
+//	}) => 1, 2, 4, ... 64
+func Unfold[A, S any](state S, fn func(S) src.Option[src.Pair[A, S]]) Iter[A] {
+	return &unfoldIter[A, S]{
+		state: state,
+		fn:    fn,
+	}
+}
+
+// Take lazily return at most n elements of iter, it is used to bound the infinite Iters
+func Take[A any](iter Iter[A], n int) Iter[A] {
+	if n < 0 {
+		n = 0
+	}
+	return &takeIter[A]{
+		from: iter,
+		n:    n,
+	}
+}
+
+// HasNext always return true
+func (gi *generateIter[A]) HasNext() bool {
+	return true
+}
+
+// Next return the next generated element
+func (gi *generateIter[A]) Next() A {
+	return gi.fn()
+}
+
+// Count return SizeInfinite without consuming the iter
+func (gi *generateIter[A]) Count() int {
+	return SizeInfinite
+}
+
+// Size return SizeInfinite
+func (gi *generateIter[A]) Size() int {
+	return SizeInfinite
+}
+
+// SizeHint return SizeInfinite
+func (gi *generateIter[A]) SizeHint() int {
+	return SizeInfinite
+}
+
+// HasNext check if there is next element, which is always true unless the underlying iter is empty
+func (ci *cycleIter[A]) HasNext() bool {
+	return len(ci.elements) > 0 || ci.from.HasNext()
+}
+
+// Next return the next element of the underlying iter, or the next buffered element once it is consumed
+func (ci *cycleIter[A]) Next() A {
+	if ci.from.HasNext() {
+		value := ci.from.Next()
+		ci.elements = append(ci.elements, value)
+		return value
+	}
+	if len(ci.elements) == 0 {
+		var zero A
+		return zero
+	}
+	value := ci.elements[ci.pos]
+	ci.pos = (ci.pos + 1) % len(ci.elements)
+	return value
+}
+
+// Count return SizeInfinite without consuming the iter, or 0 if the underlying iter is empty
+func (ci *cycleIter[A]) Count() int {
+	if ci.HasNext() {
+		return SizeInfinite
+	}
+	return 0
+}
+
+// Size return SizeInfinite, or 0 if the underlying iter is empty
+// or SizeUnknown if it is not known yet whether the underlying iter is empty
+func (ci *cycleIter[A]) Size() int {
+	if len(ci.elements) > 0 {
+		return SizeInfinite
+	}
+	switch size := ci.from.Size(); size {
+	case 0, SizeUnknown:
+		return size
+	default:
+		return SizeInfinite
+	}
+}
+
+// SizeHint same as Size
+func (ci *cycleIter[A]) SizeHint() int {
+	return ci.Size()
+}
+
+// HasNext check if there is next element, by applying fn on the current state
+func (ui *unfoldIter[A, S]) HasNext() bool {
+	if ui.fetched {
+		return true
+	}
+	if ui.done {
+		return false
+	}
+	option := ui.fn(ui.state)
+	if option.IsNone() {
+		ui.done = true
+		return false
+	}
+	next := option.Get()
+	ui.next, ui.state, ui.fetched = next.First, next.Second, true
+	return true
+}
+
+// Next return the next element if available
+func (ui *unfoldIter[A, S]) Next() A {
+	var zero A
+	if !ui.HasNext() {
+		return zero
+	}
+	value := ui.next
+	ui.next, ui.fetched = zero, false
+	return value
+}
+
+// Count return the number of the remaining elements and move to the end of the iter
+// please note that it never returns if fn never return None
+func (ui *unfoldIter[A, S]) Count() int {
+	count := 0
+	for ui.HasNext() {
+		ui.Next()
+		count++
+	}
+	return count
+}
+
+// Size return SizeUnknown, or 0 once the iter ended
+func (ui *unfoldIter[A, S]) Size() int {
+	if ui.done {
+		return 0
+	}
+	return SizeUnknown
+}
+
+// SizeHint same as Size
+func (ui *unfoldIter[A, S]) SizeHint() int {
+	return ui.Size()
+}
+
+// HasNext check if there is next element
+func (ti *takeIter[A]) HasNext() bool {
+	return ti.n > 0 && ti.from.HasNext()
+}
+
+// Next return the next element if available
+func (ti *takeIter[A]) Next() A {
+	if !ti.HasNext() {
+		var zero A
+		return zero
+	}
+	ti.n--
+	return ti.from.Next()
+}
+
+// Count return the number of the remaining elements and move to the end of the iter
+func (ti *takeIter[A]) Count() int {
+	count := 0
+	for ti.HasNext() {
+		ti.Next()
+		count++
+	}
+	return count
+}
+
+// Size return the number of the remaining elements, or SizeUnknown if the size of the underlying iter is unknown
+func (ti *takeIter[A]) Size() int {
+	switch size := ti.from.Size(); {
+	case size == SizeInfinite:
+		return ti.n
+	case size < 0:
+		return SizeUnknown
+	default:
+		return min(size, ti.n)
+	}
+}
@@ -0,0 +1,122 @@
+package iter
+
+import (
+	"github.com/sghaida/fpv2/src"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	calls := 0
+	iter := Generate(func() int {
+		calls++
+		return calls
+	})
+	assert.Equal(t, 0, calls)
+	assert.True(t, iter.HasNext())
+	assert.Equal(t, SizeInfinite, iter.Size())
+	assert.Equal(t, SizeInfinite, iter.Count())
+	assert.Equal(t, SizeInfinite, SizeHint(iter))
+	assert.Equal(t, []int{1, 2, 3}, collect(Take(iter, 3)))
+}
+
+func TestIterate(t *testing.T) {
+	powers := Iterate(1, func(i int) int {
+		return i * 2
+	})
+	assert.Equal(t, []int{1, 2, 4, 8, 16}, collect(Take(powers, 5)))
+	assert.Equal(t, 32, powers.Next())
+}
+
+func TestRepeat(t *testing.T) {
+	iter := Repeat("a")
+	assert.Equal(t, []string{"a", "a", "a"}, collect(Take(iter, 3)))
+	assert.Equal(t, SizeInfinite, iter.Size())
+}
+
+func TestCycle(t *testing.T) {
+	t.Run("repeats the elements", func(t *testing.T) {
+		iter := Cycle[int](FromSlice([]int{1, 2, 3}))
+		assert.Equal(t, SizeInfinite, iter.Size())
+		assert.Equal(t, []int{1, 2, 3, 1, 2, 3, 1}, collect(Take(iter, 7)))
+		assert.Equal(t, SizeInfinite, iter.Count())
+		assert.Equal(t, 2, iter.Next())
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		iter := Cycle[int](Empty[int]())
+		assert.Equal(t, 0, iter.Size())
+		assert.Equal(t, 0, iter.Count())
+		assert.False(t, iter.HasNext())
+		assert.Equal(t, 0, iter.Next())
+	})
+
+	t.Run("unknown size", func(t *testing.T) {
+		iter := Cycle(FromSeq(ToSeq[int](FromSlice([]int{1}))))
+		assert.Equal(t, SizeUnknown, iter.Size())
+		assert.Equal(t, 1, iter.Next())
+		assert.Equal(t, SizeInfinite, SizeHint(iter))
+	})
+}
+
+func TestUnfold(t *testing.T) {
+	powers := func(limit int) Iter[int] {
+		return Unfold(1, func(s int) src.Option[src.Pair[int, int]] {
+			if s > limit {
+				return src.None[src.Pair[int, int]]()
+			}
+			return src.NewOptional(src.NewPair(s, s*2))
+		})
+	}
+	iter := powers(100)
+	assert.Equal(t, SizeUnknown, SizeHint(iter))
+	assert.Equal(t, []int{1, 2, 4, 8, 16, 32, 64}, collect(iter))
+	assert.Equal(t, 0, iter.Size())
+	assert.Equal(t, 0, iter.Next())
+
+	assert.Equal(t, 7, powers(100).Count())
+	assert.False(t, powers(0).HasNext())
+}
+
+func TestTake(t *testing.T) {
+	t.Run("finite iter", func(t *testing.T) {
+		iter := Take[int](FromSlice([]int{1, 2, 3}), 2)
+		assert.Equal(t, 2, iter.Size())
+		assert.Equal(t, 2, iter.Count())
+		assert.False(t, iter.HasNext())
+
+		iter = Take[int](FromSlice([]int{1, 2, 3}), 5)
+		assert.Equal(t, 3, iter.Size())
+		assert.Empty(t, collect(Take[int](FromSlice([]int{1}), -1)))
+	})
+
+	t.Run("is lazy", func(t *testing.T) {
+		source := FromSlice([]int{1, 2, 3})
+		iter := Take[int](source, 2)
+		assert.Equal(t, 1, iter.Next())
+		assert.Equal(t, 2, source.Size())
+	})
+
+	t.Run("unknown size", func(t *testing.T) {
+		iter := Take(FromSeq(ToSeq[int](FromSlice([]int{1, 2}))), 5)
+		assert.Equal(t, SizeUnknown, iter.Size())
+		assert.Equal(t, 2, iter.Count())
+	})
+}
+
+func TestInfiniteOps(t *testing.T) {
+	naturals := func() Iter[int] {
+		return Iterate(0, func(i int) int { return i + 1 })
+	}
+	even := func(i int) bool { return i%2 == 0 }
+
+	assert.Equal(t, SizeInfinite, Map(naturals(), func(i int) int { return i }).Count())
+	assert.Equal(t, SizeUnknown, Filter(naturals(), even).Size())
+	assert.Equal(t, SizeInfinite, SizeHint(Filter(naturals(), even)))
+	assert.Equal(t, SizeUnknown, SizeHint(TakeWhile(naturals(), even)))
+	assert.Equal(t, SizeInfinite, Filter(naturals(), even).Count())
+	assert.Equal(t, SizeInfinite, Chain[int](FromSlice([]int{1}), naturals()).Count())
+	assert.Equal(t, SizeUnknown, TakeWhile(naturals(), even).Size())
+	assert.Equal(t, []int{0, 2, 4}, collect(Take(Filter(naturals(), even), 3)))
+	assert.Equal(t, 5, TakeWhile(naturals(), func(i int) bool { return i < 5 }).Count())
+}
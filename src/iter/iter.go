@@ -7,6 +7,13 @@ type Number interface {
 		~int | ~int8 | ~int16 | ~int32 | ~int64
 }
 
+const (
+	// SizeUnknown is returned by Size when the number of elements can't be known without consuming the Iter
+	SizeUnknown = -1
+	// SizeInfinite is returned by Size and Count of the Iters that never end, instead of hanging
+	SizeInfinite = -2
+)
+
 // Iter interface holds 2 methods
 // Next => to return current value
 // Count => to return the size of the iter
@@ -16,3 +23,18 @@ type Iter[A any] interface {
 	Count() int
 	Size() int
 }
+
+// SizeHinter is implemented by the Iters whose size is not known upfront, e.g. the generators
+// or the lazy filters which return an upper bound of the number of their elements
+type SizeHinter interface {
+	SizeHint() int
+}
+
+// SizeHint return the size of the Iter without consuming it,
+// which is either the number of the remaining elements, an upper bound of it, SizeUnknown or SizeInfinite
+func SizeHint[A any](iter Iter[A]) int {
+	if hinter, ok := iter.(SizeHinter); ok {
+		return hinter.SizeHint()
+	}
+	return iter.Size()
+}
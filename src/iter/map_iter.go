@@ -1,8 +1,12 @@
 // Package iter ...
 package iter
 
+import stditer "iter"
+
 // MapOps include the operations that can be done on a MapIter
 type MapOps[A comparable, B any] interface {
+	All() stditer.Seq2[A, B]
+	Entries() stditer.Seq[MapEntry[A, B]]
 	Clone() MapIter[A, B]
 	Contains(key A) bool
 	Filter(fn func(key A) bool) MapIter[A, B]
@@ -142,6 +146,16 @@ func (mi *mapIter[A, B]) Foreach(fn func(key A, value B)) {
 	}
 }
 
+// All return iter.Seq2 of key, value that consumes the MapIter while ranging over it
+func (mi *mapIter[A, B]) All() stditer.Seq2[A, B] {
+	return ToSeq2[A, B](mi)
+}
+
+// Entries return iter.Seq of MapEntry that consumes the MapIter while ranging over it
+func (mi *mapIter[A, B]) Entries() stditer.Seq[MapEntry[A, B]] {
+	return ToSeq[MapEntry[A, B]](mi)
+}
+
 // Map maps F: A, B => any
 func (mi *mapIter[A, B]) Map(fn func(key A, value B) any) MapIter[A, any] {
 	m := make(map[A]any)
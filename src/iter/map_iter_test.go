@@ -158,3 +158,19 @@ func TestMapIter_ToSlice(t *testing.T) {
 	slice := iter.ToSlice()
 	assert.Equal(t, len(slice), 4)
 }
+
+func TestMapIter_All(t *testing.T) {
+	in := map[string]int{"a": 1, "b": 2}
+	out := map[string]int{}
+	for key, value := range FromMap(map[string]int{"a": 1, "b": 2}).All() {
+		out[key] = value
+	}
+	assert.Equal(t, out, in)
+
+	entries := 0
+	for entry := range FromMap(map[string]int{"a": 1, "b": 2}).Entries() {
+		assert.Equal(t, entry.Val, in[entry.Key])
+		entries++
+	}
+	assert.Equal(t, entries, 2)
+}
@@ -1,6 +1,8 @@
 // Package iter ...
 package iter
 
+import "github.com/sghaida/fpv2/src"
+
 // MapOpIter interface wraps basic Iter
 type MapOpIter[A, B any] interface {
 	Iter[A]
@@ -47,3 +49,33 @@ func (moi *mapOpIter[A, B]) Count() int {
 func (moi *mapOpIter[A, B]) Size() int {
 	return moi.from.Size()
 }
+
+// Enumerate lazily pairs every element with its index starting from 0
+func Enumerate[A any](iter Iter[A]) Iter[src.Pair[int, A]] {
+	index := -1
+	return Map(iter, func(value A) src.Pair[int, A] {
+		index++
+		return src.NewPair(index, value)
+	})
+}
+
+// Scan lazily applies fn on an accumulator and each element and return every intermediate accumulator
+// e.g.
+//
+//	Scan(FromSlice([]int{1, 2, 3}), 0, sum) => 1, 3, 6
+func Scan[A, B any](iter Iter[A], initialValue B, fn func(acc B, value A) B) Iter[B] {
+	acc := initialValue
+	return Map(iter, func(value A) B {
+		acc = fn(acc, value)
+		return acc
+	})
+}
+
+// Inspect lazily applies the side effect fn on every element while it is being consumed
+// and return the elements unchanged, please note that Count doesn't apply fn
+func Inspect[A any](iter Iter[A], fn func(A)) Iter[A] {
+	return Map(iter, func(value A) A {
+		fn(value)
+		return value
+	})
+}
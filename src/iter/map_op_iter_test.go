@@ -0,0 +1,41 @@
+package iter
+
+import (
+	"github.com/sghaida/fpv2/src"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestEnumerate(t *testing.T) {
+	enumerated := Enumerate[string](FromSlice([]string{"a", "b", "c"}))
+	assert.Equal(t, 3, enumerated.Size())
+	assert.Equal(t, []src.Pair[int, string]{
+		src.NewPair(0, "a"),
+		src.NewPair(1, "b"),
+		src.NewPair(2, "c"),
+	}, collect(enumerated))
+}
+
+func TestScan(t *testing.T) {
+	sums := Scan[int, int](FromSlice([]int{1, 2, 3, 4}), 0, func(acc, i int) int {
+		return acc + i
+	})
+	assert.Equal(t, []int{1, 3, 6, 10}, collect(sums))
+
+	empty := Scan[int, int](Empty[int](), 10, func(acc, i int) int {
+		return acc + i
+	})
+	assert.False(t, empty.HasNext())
+}
+
+func TestInspect(t *testing.T) {
+	var seen []int
+	inspected := Inspect[int](FromSlice([]int{1, 2, 3}), func(i int) {
+		seen = append(seen, i)
+	})
+	assert.Empty(t, seen)
+	assert.Equal(t, 1, inspected.Next())
+	assert.Equal(t, []int{1}, seen)
+	assert.Equal(t, []int{2, 3}, collect(inspected))
+	assert.Equal(t, []int{1, 2, 3}, seen)
+}
@@ -0,0 +1,50 @@
+// Package iter ...
+package iter
+
+import "github.com/sghaida/fpv2/src"
+
+// FromOption creates SliceIter from Option, with one element in case of Some and empty in case of None
+func FromOption[A any](option src.Option[A]) SliceIter[A] {
+	if option.IsNone() {
+		return FromSlice([]A{})
+	}
+	return FromSlice([]A{option.Get()})
+}
+
+// FromEither creates SliceIter over the Right side of the Either
+// with one element in case of Right and empty in case of Left
+func FromEither[L, R any](either src.Either[L, R]) SliceIter[R] {
+	right, err := either.TakeRight()
+	if err != nil {
+		return FromSlice([]R{})
+	}
+	return FromSlice([]R{right})
+}
+
+// FlattenOptions lazily creates an Iter of the Some values and skip the None values
+func FlattenOptions[A any](iter Iter[src.Option[A]]) Iter[A] {
+	return filterMap(iter, func(option src.Option[A]) (A, bool) {
+		return option.Get(), option.IsSome()
+	})
+}
+
+// CollectRights consume the iter and collect the Right values in order, while the Left values are skipped
+func CollectRights[L, R any](iter Iter[src.Either[L, R]]) SliceIter[R] {
+	var out []R
+	for iter.HasNext() {
+		if right, err := iter.Next().TakeRight(); err == nil {
+			out = append(out, right)
+		}
+	}
+	return FromSlice(out)
+}
+
+// FirstSome consume the iter up to the first Some and return it, or None if there is no Some
+func FirstSome[A any](iter Iter[src.Option[A]]) src.Option[A] {
+	for iter.HasNext() {
+		if option := iter.Next(); option.IsSome() {
+			return option
+		}
+	}
+	return src.None[A]()
+}
@@ -0,0 +1,103 @@
+package iter
+
+import (
+	"errors"
+	"github.com/sghaida/fpv2/src"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+func TestFromOption(t *testing.T) {
+	some := FromOption(src.NewOptional(10))
+	assert.Equal(t, 1, some.Size())
+	assert.True(t, some.HasNext())
+	assert.Equal(t, 10, some.Next())
+	assert.False(t, some.HasNext())
+
+	none := FromOption(src.None[int]())
+	assert.Equal(t, 0, none.Size())
+	assert.False(t, none.HasNext())
+
+	t.Run("in slice iter chains", func(t *testing.T) {
+		filtered := FromOption(src.NewOptional(10)).Filter(func(value int) bool {
+			return value > 5
+		})
+		assert.Equal(t, []int{10}, filtered.ToSlice())
+
+		mapped := Map[int, string](FromOption(src.NewOptional(10)), strconv.Itoa)
+		assert.Equal(t, "10", mapped.Next())
+	})
+}
+
+func TestFromEither(t *testing.T) {
+	right := FromEither(src.Right[error, int](10))
+	assert.Equal(t, []int{10}, right.ToSlice())
+
+	zero := FromEither(src.RightUnchecked[error, []int](nil))
+	assert.Equal(t, 1, zero.Size())
+
+	left := FromEither(src.Left[error, int](errors.New("missing")))
+	assert.Equal(t, 0, left.Size())
+	assert.False(t, left.HasNext())
+}
+
+func TestFlattenOptions(t *testing.T) {
+	options := []src.Option[int]{
+		src.None[int](), src.NewOptional(1), src.None[int](), src.NewOptional(2), src.None[int](),
+	}
+
+	t.Run("next", func(t *testing.T) {
+		iter := FlattenOptions[int](FromSlice(options))
+		var out []int
+		for iter.HasNext() {
+			out = append(out, iter.Next())
+		}
+		assert.Equal(t, []int{1, 2}, out)
+		assert.Equal(t, 0, iter.Next())
+	})
+
+	t.Run("next without has next", func(t *testing.T) {
+		iter := FlattenOptions[int](FromSlice(options))
+		assert.Equal(t, 1, iter.Next())
+		assert.Equal(t, 2, iter.Next())
+		assert.Equal(t, 0, iter.Next())
+	})
+
+	t.Run("count", func(t *testing.T) {
+		iter := FlattenOptions[int](FromSlice(options))
+		assert.Equal(t, SizeUnknown, iter.Size())
+		assert.Equal(t, 5, SizeHint(iter))
+		assert.Equal(t, 2, iter.Count())
+		assert.Equal(t, 0, iter.Size())
+		assert.False(t, iter.HasNext())
+	})
+
+	t.Run("lazy", func(t *testing.T) {
+		pulled := 0
+		source := Map[int, src.Option[int]](FromSlice([]int{0, 1, 2, 3}), func(value int) src.Option[int] {
+			pulled++
+			return src.NewOptional(value).Filter(func(v int) bool { return v%2 == 1 })
+		})
+		iter := FlattenOptions(source)
+		assert.Equal(t, 0, pulled)
+		assert.Equal(t, 1, iter.Next())
+		assert.Equal(t, 2, pulled)
+	})
+}
+
+func TestCollectRights(t *testing.T) {
+	eithers := []src.Either[error, int]{
+		src.Right[error, int](1), src.Left[error, int](errors.New("missing")), src.Right[error, int](2),
+	}
+	rights := CollectRights[error, int](FromSlice(eithers))
+	assert.Equal(t, []int{1, 2}, rights.ToSlice())
+	assert.Equal(t, 2, rights.Size())
+}
+
+func TestFirstSome(t *testing.T) {
+	options := FromSlice([]src.Option[string]{src.None[string](), src.NewOptional("a"), src.NewOptional("b")})
+	assert.Equal(t, src.NewOptional("a"), FirstSome[string](options))
+	assert.Equal(t, src.NewOptional("b"), FirstSome[string](options))
+	assert.True(t, FirstSome[string](options).IsNone())
+}
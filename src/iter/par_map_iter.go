@@ -0,0 +1,260 @@
+// Package iter ...
+package iter
+
+import (
+	"context"
+	"sync"
+)
+
+// ParOption configures ParMap and ParMapUnordered
+type ParOption func(*parConfig)
+
+type parConfig struct {
+	buffer int
+}
+
+// WithBuffer sets the number of the results that can wait for the consumer on top of the ones being computed by the workers,
+// it only controls how far src is read ahead and never limits the number of the busy workers, it defaults to the number of workers
+func WithBuffer(n int) ParOption {
+	return func(config *parConfig) {
+		if n < 0 {
+			n = 0
+		}
+		config.buffer = n
+	}
+}
+
+type parResult[B any] struct {
+	value     B
+	panicked  bool
+	recovered any
+}
+
+type parJob[A, B any] struct {
+	value  A
+	future chan parResult[B]
+}
+
+type parIter[B any] struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	receive func() (parResult[B], bool)
+	next    parResult[B]
+	fetched bool
+	done    bool
+}
+
+// ParMap applies fn concurrently on the elements of src using a bounded number of workers and return the results in the input order
+// src is consumed sequentially by a single goroutine, a panic in fn or src is re-raised by Next of the returned Iter.
+// the goroutines are released once the Iter is exhausted, panics or ctx is cancelled,
+// so ctx has to be cancelled if the Iter is abandoned.
+// please note that src is pulled ahead of the consumer, so on cancellation up to workers + buffer + 2 elements
+// that were already pulled from src are dropped, the rest of src is left untouched
+func ParMap[A, B any](ctx context.Context, src Iter[A], workers int, fn func(A) B, opts ...ParOption) Iter[B] {
+	workers, config := parSetup(workers, opts)
+	ctx, cancel := context.WithCancel(ctx)
+	jobs := make(chan parJob[A, B])
+	// the workers are kept busy while the buffered results wait for the consumer
+	futures := make(chan chan parResult[B], workers+config.buffer)
+
+	for i := 0; i < workers; i++ {
+		go func() {
+			for job := range jobs {
+				job.future <- parApply(fn, job.value)
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+		defer close(futures)
+		defer func() {
+			if r := recover(); r != nil {
+				future := make(chan parResult[B], 1)
+				future <- parResult[B]{panicked: true, recovered: r}
+				select {
+				case futures <- future:
+				case <-ctx.Done():
+				}
+			}
+		}()
+		for ctx.Err() == nil && src.HasNext() {
+			job := parJob[A, B]{value: src.Next(), future: make(chan parResult[B], 1)}
+			select {
+			case futures <- job.future:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case jobs <- job:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return &parIter[B]{
+		ctx:    ctx,
+		cancel: cancel,
+		receive: func() (parResult[B], bool) {
+			var future chan parResult[B]
+			select {
+			case future = <-futures:
+				if future == nil {
+					return parResult[B]{}, false
+				}
+			case <-ctx.Done():
+				return parResult[B]{}, false
+			}
+			select {
+			case result := <-future:
+				return result, true
+			case <-ctx.Done():
+				return parResult[B]{}, false
+			}
+		},
+	}
+}
+
+// ParMapUnordered applies fn concurrently on the elements of src using a bounded number of workers
+// and return the results as soon as they are computed, it behaves like ParMap otherwise,
+// except that on cancellation up to workers + buffer + 1 pulled elements are dropped
+func ParMapUnordered[A, B any](ctx context.Context, src Iter[A], workers int, fn func(A) B, opts ...ParOption) Iter[B] {
+	workers, config := parSetup(workers, opts)
+	ctx, cancel := context.WithCancel(ctx)
+	jobs := make(chan A)
+	results := make(chan parResult[B], config.buffer)
+	send := func(result parResult[B]) bool {
+		select {
+		case results <- result:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(workers + 1)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			for value := range jobs {
+				if !send(parApply(fn, value)) {
+					return
+				}
+			}
+		}()
+	}
+
+	go func() {
+		defer wg.Done()
+		defer close(jobs)
+		defer func() {
+			if r := recover(); r != nil {
+				send(parResult[B]{panicked: true, recovered: r})
+			}
+		}()
+		for ctx.Err() == nil && src.HasNext() {
+			value := src.Next()
+			select {
+			case jobs <- value:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	return &parIter[B]{
+		ctx:    ctx,
+		cancel: cancel,
+		receive: func() (parResult[B], bool) {
+			select {
+			case result, ok := <-results:
+				return result, ok
+			case <-ctx.Done():
+				return parResult[B]{}, false
+			}
+		},
+	}
+}
+
+// parSetup applies the options and make sure that there is at least one worker
+func parSetup(workers int, opts []ParOption) (int, parConfig) {
+	if workers < 1 {
+		workers = 1
+	}
+	config := parConfig{buffer: workers}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	return workers, config
+}
+
+// parApply applies fn on value and recovers the panic if any, so it can be re-raised by the consumer
+func parApply[A, B any](fn func(A) B, value A) (result parResult[B]) {
+	defer func() {
+		if r := recover(); r != nil {
+			result = parResult[B]{panicked: true, recovered: r}
+		}
+	}()
+	return parResult[B]{value: fn(value)}
+}
+
+// HasNext check if there is next element, it blocks until the next result is available
+func (pi *parIter[B]) HasNext() bool {
+	if pi.fetched {
+		return true
+	}
+	if pi.done || pi.ctx.Err() != nil {
+		pi.stop()
+		return false
+	}
+	result, ok := pi.receive()
+	if !ok {
+		pi.stop()
+		return false
+	}
+	pi.next, pi.fetched = result, true
+	return true
+}
+
+// Next return the next element if available, it re-raises the panic of fn if any
+func (pi *parIter[B]) Next() B {
+	if !pi.HasNext() {
+		var zero B
+		return zero
+	}
+	result := pi.next
+	pi.next, pi.fetched = parResult[B]{}, false
+	if result.panicked {
+		pi.stop()
+		panic(result.recovered)
+	}
+	return result.value
+}
+
+// Count return the number of the remaining elements and move to the end of the iter
+func (pi *parIter[B]) Count() int {
+	count := 0
+	for pi.HasNext() {
+		pi.Next()
+		count++
+	}
+	return count
+}
+
+// Size return SizeUnknown, since src is being consumed concurrently
+func (pi *parIter[B]) Size() int {
+	return SizeUnknown
+}
+
+// stop releases the goroutines
+func (pi *parIter[B]) stop() {
+	pi.done = true
+	pi.cancel()
+}
@@ -0,0 +1,159 @@
+package iter
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"sort"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParMap(t *testing.T) {
+	in := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	// slowDouble sleeps longer for the first elements, so the results are computed out of order
+	slowDouble := func(i int) int {
+		time.Sleep(time.Duration(len(in)-i) * time.Millisecond)
+		return i * 2
+	}
+
+	t.Run("preserves the input order", func(t *testing.T) {
+		verifyNoLeaks(t)
+		iter := ParMap(context.Background(), FromSlice(in), 4, slowDouble)
+		assert.Equal(t, SizeUnknown, iter.Size())
+		assert.Equal(t, []int{2, 4, 6, 8, 10, 12, 14, 16, 18, 20}, collect(iter))
+		assert.Equal(t, 0, iter.Next())
+	})
+
+	t.Run("bounded workers", func(t *testing.T) {
+		verifyNoLeaks(t)
+		var running, maxRunning atomic.Int32
+		iter := ParMap(context.Background(), FromSlice(in), 3, func(i int) int {
+			current := running.Add(1)
+			defer running.Add(-1)
+			for {
+				seen := maxRunning.Load()
+				if current <= seen || maxRunning.CompareAndSwap(seen, current) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			return i
+		}, WithBuffer(1))
+		assert.Equal(t, len(in), iter.Count())
+		assert.LessOrEqual(t, maxRunning.Load(), int32(3))
+		assert.Greater(t, maxRunning.Load(), int32(1))
+	})
+
+	t.Run("zero buffer keeps all the workers busy", func(t *testing.T) {
+		verifyNoLeaks(t)
+		var running, maxRunning atomic.Int32
+		iter := ParMap(context.Background(), FromSlice(in), 4, func(i int) int {
+			current := running.Add(1)
+			defer running.Add(-1)
+			for {
+				seen := maxRunning.Load()
+				if current <= seen || maxRunning.CompareAndSwap(seen, current) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			return i
+		}, WithBuffer(0))
+		assert.Equal(t, in, collect(iter))
+		assert.Equal(t, int32(4), maxRunning.Load())
+	})
+
+	t.Run("re-raises the panic in Next", func(t *testing.T) {
+		verifyNoLeaks(t)
+		iter := ParMap(context.Background(), FromSlice(in), 2, func(i int) int {
+			if i == 3 {
+				panic("boom")
+			}
+			return i
+		})
+		assert.Equal(t, 1, iter.Next())
+		assert.Equal(t, 2, iter.Next())
+		assert.PanicsWithValue(t, "boom", func() { iter.Next() })
+		assert.False(t, iter.HasNext())
+	})
+
+	t.Run("re-raises the panic of src", func(t *testing.T) {
+		verifyNoLeaks(t)
+		src := Map(FromSlice(in), func(i int) int {
+			if i == 2 {
+				panic("src")
+			}
+			return i
+		})
+		iter := ParMap(context.Background(), src, 2, func(i int) int { return i })
+		assert.Equal(t, 1, iter.Next())
+		assert.PanicsWithValue(t, "src", func() { iter.Next() })
+	})
+
+	t.Run("stops on cancel", func(t *testing.T) {
+		verifyNoLeaks(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		src := FromSlice(in)
+		iter := ParMap(ctx, src, 2, slowDouble, WithBuffer(0))
+		assert.Equal(t, 2, iter.Next())
+		cancel()
+		assert.False(t, iter.HasNext())
+	})
+
+	t.Run("empty src", func(t *testing.T) {
+		verifyNoLeaks(t)
+		iter := ParMap(context.Background(), Empty[int](), 0, slowDouble)
+		assert.False(t, iter.HasNext())
+	})
+}
+
+func TestParMapUnordered(t *testing.T) {
+	in := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	double := func(i int) int {
+		time.Sleep(time.Duration(len(in)-i) * time.Millisecond)
+		return i * 2
+	}
+
+	t.Run("return all the results", func(t *testing.T) {
+		verifyNoLeaks(t)
+		out := collect(ParMapUnordered(context.Background(), FromSlice(in), 4, double, WithBuffer(2)))
+		sort.Ints(out)
+		assert.Equal(t, []int{2, 4, 6, 8, 10, 12, 14, 16, 18, 20}, out)
+	})
+
+	t.Run("re-raises the panic in Next", func(t *testing.T) {
+		verifyNoLeaks(t)
+		iter := ParMapUnordered(context.Background(), FromSlice(in), 2, func(i int) int {
+			panic("boom")
+		})
+		assert.PanicsWithValue(t, "boom", func() { iter.Next() })
+		assert.False(t, iter.HasNext())
+	})
+
+	t.Run("stops on cancel", func(t *testing.T) {
+		verifyNoLeaks(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		iter := ParMapUnordered(ctx, FromSlice(in), 2, double)
+		iter.Next()
+		cancel()
+		assert.False(t, iter.HasNext())
+		assert.Equal(t, 0, iter.Count())
+	})
+
+	t.Run("drops at most workers + buffer + 1 pulled elements on cancel", func(t *testing.T) {
+		verifyNoLeaks(t)
+		const workers, buffer = 2, 1
+		var pulled atomic.Int32
+		ctx, cancel := context.WithCancel(context.Background())
+		src := Inspect[int](FromSlice(in), func(int) { pulled.Add(1) })
+		iter := ParMapUnordered(ctx, src, workers, double, WithBuffer(buffer))
+		iter.Next()
+		iter.Next()
+		cancel()
+		assert.False(t, iter.HasNext())
+		dropped := int(pulled.Load()) - 2
+		assert.GreaterOrEqual(t, dropped, 0)
+		assert.LessOrEqual(t, dropped, workers+buffer+1)
+	})
+}
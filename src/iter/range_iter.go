@@ -3,10 +3,12 @@ package iter
 
 import (
 	"errors"
+	stditer "iter"
 )
 
 // RangeOps list of operations on RangeIter
 type RangeOps[A any] interface {
+	All() stditer.Seq[A]
 	Contains(elm A) bool
 	Filter(fn func(A) bool) SliceIter[A]
 	Fold(fn func(A, A) A) A
@@ -168,6 +170,11 @@ func (ri *rangeIter[A]) Foreach(fn func(A)) {
 	}
 }
 
+// All return iter.Seq that consumes the Iter while ranging over it
+func (ri *rangeIter[A]) All() stditer.Seq[A] {
+	return ToSeq[A](ri)
+}
+
 // Slice Creates an iterator returning an interval of the values produced by this iterator.
 func (ri *rangeIter[A]) Slice(from, until A) SliceIter[A] {
 	// ri is beyond the end of the Iter or ri is negative
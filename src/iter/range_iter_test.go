@@ -212,3 +212,16 @@ func TestRangeIter_ToSlice(t *testing.T) {
 	slice := iter.ToSlice()
 	assert.Equal(t, slice, []int{1, 2, 3, 4, 5, 6, 7, 8, 9})
 }
+
+func TestRangeIter_All(t *testing.T) {
+	iter, _ := Range(0, 10, 2)
+	var values []int
+	for value := range iter.All() {
+		if value > 4 {
+			break
+		}
+		values = append(values, value)
+	}
+	assert.Equal(t, values, []int{0, 2, 4})
+	assert.True(t, iter.HasNext())
+}
@@ -0,0 +1,127 @@
+// Package iter ...
+package iter
+
+import (
+	stditer "iter"
+	"maps"
+)
+
+// PullIter is an Iter that pulls its elements from a push iterator of the standard library
+// it has to be stopped using Stop if it is abandoned before being exhausted
+type PullIter[A any] interface {
+	Iter[A]
+	Stop()
+}
+
+type pullIter[A any] struct {
+	next    func() (A, bool)
+	stop    func()
+	value   A
+	fetched bool
+	done    bool
+}
+
+// ToSeq converts an Iter to iter.Seq, so that it can be used with for range
+// the elements are consumed from the Iter while ranging, breaking early leaves the rest of them untouched
+func ToSeq[A any](iter Iter[A]) stditer.Seq[A] {
+	return func(yield func(A) bool) {
+		for iter.HasNext() {
+			if !yield(iter.Next()) {
+				return
+			}
+		}
+	}
+}
+
+// ToSeq2 converts a MapIter to iter.Seq2 of key, value
+// the entries are consumed from the MapIter while ranging, breaking early leaves the rest of them untouched
+func ToSeq2[K comparable, V any](iter MapIter[K, V]) stditer.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for iter.HasNext() {
+			entry := iter.Next()
+			if !yield(entry.Key, entry.Val) {
+				return
+			}
+		}
+	}
+}
+
+// FromSeq lazily converts iter.Seq to an Iter, the Seq is pulled only when the elements are requested
+// the Iter is stopped once it is exhausted, otherwise Stop has to be called to release the Seq
+func FromSeq[A any](seq stditer.Seq[A]) PullIter[A] {
+	next, stop := stditer.Pull(seq)
+	return &pullIter[A]{
+		next: next,
+		stop: stop,
+	}
+}
+
+// FromSeq2 lazily converts iter.Seq2 to an Iter of MapEntry, use MapFromSeq2 to get a MapIter instead
+// the Iter is stopped once it is exhausted, otherwise Stop has to be called to release the Seq2
+func FromSeq2[K comparable, V any](seq stditer.Seq2[K, V]) PullIter[MapEntry[K, V]] {
+	next, stop := stditer.Pull2(seq)
+	return &pullIter[MapEntry[K, V]]{
+		next: func() (MapEntry[K, V], bool) {
+			key, value, ok := next()
+			return MapEntry[K, V]{Key: key, Val: value}, ok
+		},
+		stop: stop,
+	}
+}
+
+// MapFromSeq2 converts iter.Seq2 to a MapIter, unlike FromSeq2 the Seq2 is consumed eagerly
+// since MapIter is backed by a map, so the Seq2 has to be finite and the last value of a repeated key wins
+func MapFromSeq2[K comparable, V any](seq stditer.Seq2[K, V]) MapIter[K, V] {
+	return FromMap(maps.Collect(seq))
+}
+
+// HasNext check if there is next element, by pulling it from the Seq
+func (pi *pullIter[A]) HasNext() bool {
+	if pi.fetched {
+		return true
+	}
+	if pi.done {
+		return false
+	}
+	value, ok := pi.next()
+	if !ok {
+		pi.Stop()
+		return false
+	}
+	pi.value, pi.fetched = value, true
+	return true
+}
+
+// Next return the next element if available
+func (pi *pullIter[A]) Next() A {
+	var zero A
+	if !pi.HasNext() {
+		return zero
+	}
+	value := pi.value
+	pi.value, pi.fetched = zero, false
+	return value
+}
+
+// Count return the number of the remaining elements and move to the end of the iter
+func (pi *pullIter[A]) Count() int {
+	count := 0
+	for pi.HasNext() {
+		pi.Next()
+		count++
+	}
+	return count
+}
+
+// Size return SizeUnknown, since the size of a Seq is known only after consuming it
+func (pi *pullIter[A]) Size() int {
+	return SizeUnknown
+}
+
+// Stop releases the underlying Seq, after which the Iter has no more elements
+func (pi *pullIter[A]) Stop() {
+	var zero A
+	pi.done = true
+	pi.value, pi.fetched = zero, false
+	pi.stop()
+}
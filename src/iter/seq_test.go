@@ -0,0 +1,100 @@
+package iter
+
+import (
+	"github.com/stretchr/testify/assert"
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestToSeq(t *testing.T) {
+	t.Run("ranges over all the elements", func(t *testing.T) {
+		assert.Equal(t, []int{1, 2, 3}, slices.Collect(ToSeq[int](FromSlice([]int{1, 2, 3}))))
+		assert.Empty(t, slices.Collect(ToSeq[int](Empty[int]())))
+	})
+
+	t.Run("break leaves the rest of the iter", func(t *testing.T) {
+		iter := FromSlice([]int{1, 2, 3, 4})
+		for value := range ToSeq[int](iter) {
+			if value == 2 {
+				break
+			}
+		}
+		assert.Equal(t, 2, iter.Size())
+		assert.Equal(t, 3, iter.Next())
+	})
+}
+
+func TestToSeq2(t *testing.T) {
+	in := map[string]int{"a": 1, "b": 2, "c": 3}
+	assert.Equal(t, in, maps.Collect(ToSeq2(FromMap(maps.Clone(in)))))
+
+	iter := FromMap(maps.Clone(in))
+	for range ToSeq2(iter) {
+		break
+	}
+	assert.Equal(t, 2, iter.Size())
+}
+
+func TestFromSeq(t *testing.T) {
+	// numbers yields from 0 to n-1 and records how many values were produced and whether it was stopped
+	numbers := func(n int, produced *int, stopped *bool) func(func(int) bool) {
+		return func(yield func(int) bool) {
+			defer func() { *stopped = true }()
+			for i := 0; i < n; i++ {
+				*produced++
+				if !yield(i) {
+					return
+				}
+			}
+		}
+	}
+
+	t.Run("pulls lazily", func(t *testing.T) {
+		produced, stopped := 0, false
+		iter := FromSeq(numbers(5, &produced, &stopped))
+		assert.Equal(t, SizeUnknown, iter.Size())
+		assert.Equal(t, 0, produced)
+		assert.True(t, iter.HasNext())
+		assert.True(t, iter.HasNext())
+		assert.Equal(t, 0, iter.Next())
+		assert.Equal(t, 1, produced)
+		assert.Equal(t, []int{1, 2, 3, 4}, collect[int](iter))
+		assert.True(t, stopped)
+		assert.Equal(t, 0, iter.Next())
+	})
+
+	t.Run("stop releases the seq", func(t *testing.T) {
+		produced, stopped := 0, false
+		iter := FromSeq(numbers(5, &produced, &stopped))
+		iter.Next()
+		iter.Stop()
+		assert.True(t, stopped)
+		assert.Equal(t, 1, produced)
+		assert.False(t, iter.HasNext())
+		assert.Equal(t, 0, iter.Count())
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		iter := FromSeq(ToSeq[int](FromSlice([]int{1, 2, 3})))
+		assert.Equal(t, 3, iter.Count())
+	})
+}
+
+func TestFromSeq2(t *testing.T) {
+	iter := FromSeq2(maps.All(map[string]int{"a": 1}))
+	assert.True(t, iter.HasNext())
+	assert.Equal(t, MapEntry[string, int]{Key: "a", Val: 1}, iter.Next())
+	assert.False(t, iter.HasNext())
+}
+
+func TestMapFromSeq2(t *testing.T) {
+	in := map[string]int{"a": 1, "b": 2}
+	iter := MapFromSeq2(maps.All(in))
+	assert.Equal(t, 2, iter.Size())
+	assert.Equal(t, in, iter.ToMap())
+
+	// round trip
+	assert.Equal(t, in, MapFromSeq2(ToSeq2(FromMap(maps.Clone(in)))).ToMap())
+	assert.Equal(t, 0, MapFromSeq2(maps.All(map[string]int{})).Size())
+}
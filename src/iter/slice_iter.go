@@ -1,8 +1,11 @@
 // Package iter ...
 package iter
 
+import stditer "iter"
+
 // SliceOps include the operations that can be done on a SliceIter
 type SliceOps[A any] interface {
+	All() stditer.Seq[A]
 	Clone() SliceIter[A]
 	Contains(elm A) bool
 	Drop(n int) SliceIter[A]
@@ -144,6 +147,11 @@ func (si *sliceIter[A]) Foreach(fn func(A)) {
 	}
 }
 
+// All return iter.Seq that consumes the Iter while ranging over it
+func (si *sliceIter[A]) All() stditer.Seq[A] {
+	return ToSeq[A](si)
+}
+
 // Slice Creates an iterator returning an interval of the values produced by this iterator.
 func (si *sliceIter[A]) Slice(from, until int) SliceIter[A] {
 	// from is beyond the end of the Iter or from is negative
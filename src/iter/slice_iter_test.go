@@ -328,3 +328,13 @@ func TestSliceIter_ToIter(t *testing.T) {
 	iter := FromSlice(in).ToIter()
 	assert.Equal(t, iter.Size(), 2)
 }
+
+func TestSliceIter_All(t *testing.T) {
+	iter := FromSlice([]int{1, 2, 3})
+	var values []int
+	for value := range iter.All() {
+		values = append(values, value)
+	}
+	assert.Equal(t, values, []int{1, 2, 3})
+	assert.False(t, iter.HasNext())
+}
@@ -0,0 +1,81 @@
+// Package iter ...
+package iter
+
+type takeWhileOpIter[A any] struct {
+	from    Iter[A]
+	fn      func(A) bool
+	next    A
+	fetched bool
+	done    bool
+}
+
+// TakeWhile lazily return the leading elements that satisfy the predicate
+// and stops at the first element that doesn't, which is consumed from the underlying Iter
+func TakeWhile[A any](iter Iter[A], fn func(A) bool) Iter[A] {
+	return &takeWhileOpIter[A]{
+		from: iter,
+		fn:   fn,
+	}
+}
+
+// HasNext check if there is next element
+func (twi *takeWhileOpIter[A]) HasNext() bool {
+	if twi.fetched {
+		return true
+	}
+	if twi.done || !twi.from.HasNext() {
+		return false
+	}
+	value := twi.from.Next()
+	if !twi.fn(value) {
+		twi.done = true
+		return false
+	}
+	twi.next, twi.fetched = value, true
+	return true
+}
+
+// Next return the next element if available
+func (twi *takeWhileOpIter[A]) Next() A {
+	var zero A
+	if !twi.HasNext() {
+		return zero
+	}
+	value := twi.next
+	twi.next, twi.fetched = zero, false
+	return value
+}
+
+// Count return the number of the remaining elements and move to the end of the iter
+func (twi *takeWhileOpIter[A]) Count() int {
+	count := 0
+	for twi.HasNext() {
+		twi.Next()
+		count++
+	}
+	return count
+}
+
+// Size return SizeUnknown, since the predicate may stop the iter at any element, or 0 once it ended
+func (twi *takeWhileOpIter[A]) Size() int {
+	if twi.done || (!twi.fetched && twi.from.Size() == 0) {
+		return 0
+	}
+	return SizeUnknown
+}
+
+// SizeHint return the size of the underlying iter, which is an upper bound of the number of elements
+func (twi *takeWhileOpIter[A]) SizeHint() int {
+	if twi.done {
+		return 0
+	}
+	size := SizeHint(twi.from)
+	if size < 0 {
+		// the predicate may stop an infinite iter
+		return SizeUnknown
+	}
+	if twi.fetched {
+		return size + 1
+	}
+	return size
+}
@@ -0,0 +1,36 @@
+package iter
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestTakeWhile(t *testing.T) {
+	lessThan3 := func(i int) bool {
+		return i < 3
+	}
+
+	t.Run("stops at the first rejected element", func(t *testing.T) {
+		source := FromSlice([]int{1, 2, 3, 1, 2})
+		taken := TakeWhile[int](source, lessThan3)
+		assert.Equal(t, SizeUnknown, taken.Size())
+		assert.Equal(t, 5, SizeHint(taken))
+		assert.Equal(t, []int{1, 2}, collect(taken))
+		assert.Equal(t, 0, taken.Size())
+		assert.Equal(t, 0, taken.Next())
+		// the rejected element is consumed, the rest is left untouched
+		assert.Equal(t, 2, source.Size())
+	})
+
+	t.Run("count", func(t *testing.T) {
+		taken := TakeWhile[int](FromSlice([]int{0, 1, 2}), lessThan3)
+		assert.Equal(t, 3, taken.Count())
+		assert.False(t, taken.HasNext())
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		taken := TakeWhile[int](Empty[int](), lessThan3)
+		assert.False(t, taken.HasNext())
+		assert.Equal(t, 0, taken.Count())
+	})
+}
@@ -0,0 +1,112 @@
+// Package src ...
+package src
+
+import (
+	"errors"
+	"fmt"
+	"github.com/sghaida/fpv2/src/utils"
+)
+
+// ErrorNonExhaustiveMatch if a matcher is executed while one of its cases is missing
+var ErrorNonExhaustiveMatch = errors.New("non exhaustive match")
+
+// FoldOption applies onSome in case of Some and onNone in case of None and returns the resulting value
+func FoldOption[A, B any](option Option[A], onSome utils.Mapper[A, B], onNone func() B) B {
+	if option.IsNone() {
+		return onNone()
+	}
+	return onSome(option.value)
+}
+
+// FoldEither takes to fn onLeft: A => C , onRight: B => C
+// and applies onLeft in case of Left and onRight in case of Right
+// and returns the resulting value, unlike Either.Fold the result keeps its type
+func FoldEither[A, B, C any](either Either[A, B], onLeft utils.Mapper[A, C], onRight utils.Mapper[B, C]) C {
+	return foldEither(either, onLeft, onRight)
+}
+
+// OptionMatcher matches an Option against its Some and None cases
+// both cases has to be provided before calling Run
+type OptionMatcher[A, B any] struct {
+	option Option[A]
+	onSome utils.Mapper[A, B]
+	onNone func() B
+}
+
+// Match creates an OptionMatcher that returns B, the type of the Option is inferred
+// e.g.
+//
+//	name := Match[string](user).
+//		Some(func(u User) string { return u.Name }).
+//		None(func() string { return "anonymous" }).
+//		Run()
+func Match[B, A any](option Option[A]) OptionMatcher[A, B] {
+	return OptionMatcher[A, B]{option: option}
+}
+
+// Some sets the function that is applied in case of Some
+func (m OptionMatcher[A, B]) Some(fn utils.Mapper[A, B]) OptionMatcher[A, B] {
+	m.onSome = fn
+	return m
+}
+
+// None sets the function that is applied in case of None
+func (m OptionMatcher[A, B]) None(fn func() B) OptionMatcher[A, B] {
+	m.onNone = fn
+	return m
+}
+
+// Run executes the matching case and return its result
+// it panics with ErrorNonExhaustiveMatch if any of the cases is missing, irrelevant of the Option Type
+func (m OptionMatcher[A, B]) Run() B {
+	if m.onSome == nil {
+		panic(fmt.Errorf("%w: missing Some case", ErrorNonExhaustiveMatch))
+	}
+	if m.onNone == nil {
+		panic(fmt.Errorf("%w: missing None case", ErrorNonExhaustiveMatch))
+	}
+	return FoldOption(m.option, m.onSome, m.onNone)
+}
+
+// EitherMatcher matches an Either against its Left and Right cases
+// both cases has to be provided before calling Run
+type EitherMatcher[A, B, C any] struct {
+	either  Either[A, B]
+	onLeft  utils.Mapper[A, C]
+	onRight utils.Mapper[B, C]
+}
+
+// MatchEither creates an EitherMatcher that returns C, the types of the Either are inferred
+// e.g.
+//
+//	status := MatchEither[int](result).
+//		Left(func(err error) int { return http.StatusInternalServerError }).
+//		Right(func(_ User) int { return http.StatusOK }).
+//		Run()
+func MatchEither[C, A, B any](either Either[A, B]) EitherMatcher[A, B, C] {
+	return EitherMatcher[A, B, C]{either: either}
+}
+
+// Left sets the function that is applied in case of Left
+func (m EitherMatcher[A, B, C]) Left(fn utils.Mapper[A, C]) EitherMatcher[A, B, C] {
+	m.onLeft = fn
+	return m
+}
+
+// Right sets the function that is applied in case of Right
+func (m EitherMatcher[A, B, C]) Right(fn utils.Mapper[B, C]) EitherMatcher[A, B, C] {
+	m.onRight = fn
+	return m
+}
+
+// Run executes the matching case and return its result
+// it panics with ErrorNonExhaustiveMatch if any of the cases is missing, irrelevant of the Either side
+func (m EitherMatcher[A, B, C]) Run() C {
+	if m.onLeft == nil {
+		panic(fmt.Errorf("%w: missing Left case", ErrorNonExhaustiveMatch))
+	}
+	if m.onRight == nil {
+		panic(fmt.Errorf("%w: missing Right case", ErrorNonExhaustiveMatch))
+	}
+	return FoldEither(m.either, m.onLeft, m.onRight)
+}
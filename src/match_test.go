@@ -0,0 +1,92 @@
+package src
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+func TestFoldOption(t *testing.T) {
+	onSome := func(value int) string { return strconv.Itoa(value) }
+	onNone := func() string { return "none" }
+
+	assert.Equal(t, "10", FoldOption(NewOptional(10), onSome, onNone))
+	assert.Equal(t, "none", FoldOption(None[int](), onSome, onNone))
+}
+
+func TestFoldEither(t *testing.T) {
+	onLeft := func(err error) int { return -1 }
+	onRight := func(value string) int { return len(value) }
+
+	assert.Equal(t, 6, FoldEither(Right[error, string]("saddam"), onLeft, onRight))
+	assert.Equal(t, -1, FoldEither(Left[error, string](errors.New("missing")), onLeft, onRight))
+}
+
+func TestMatch(t *testing.T) {
+	t.Run("some", func(t *testing.T) {
+		result := Match[string](NewOptional(10)).
+			Some(func(value int) string { return strconv.Itoa(value) }).
+			None(func() string { return "none" }).
+			Run()
+		assert.Equal(t, "10", result)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		result := Match[string](None[int]()).
+			None(func() string { return "none" }).
+			Some(func(value int) string { return strconv.Itoa(value) }).
+			Run()
+		assert.Equal(t, "none", result)
+	})
+
+	t.Run("missing some case", func(t *testing.T) {
+		assert.PanicsWithError(t, "non exhaustive match: missing Some case", func() {
+			Match[string](None[int]()).
+				None(func() string { return "none" }).
+				Run()
+		})
+	})
+
+	t.Run("missing none case", func(t *testing.T) {
+		assert.PanicsWithError(t, "non exhaustive match: missing None case", func() {
+			Match[string](NewOptional(10)).
+				Some(func(value int) string { return strconv.Itoa(value) }).
+				Run()
+		})
+	})
+}
+
+func TestMatchEither(t *testing.T) {
+	t.Run("right", func(t *testing.T) {
+		result := MatchEither[int](Right[error, string]("saddam")).
+			Left(func(err error) int { return -1 }).
+			Right(func(value string) int { return len(value) }).
+			Run()
+		assert.Equal(t, 6, result)
+	})
+
+	t.Run("left", func(t *testing.T) {
+		result := MatchEither[int](Left[error, string](errors.New("missing"))).
+			Right(func(value string) int { return len(value) }).
+			Left(func(err error) int { return -1 }).
+			Run()
+		assert.Equal(t, -1, result)
+	})
+
+	t.Run("missing left case", func(t *testing.T) {
+		assert.PanicsWithError(t, "non exhaustive match: missing Left case", func() {
+			MatchEither[int](Right[error, string]("saddam")).
+				Right(func(value string) int { return len(value) }).
+				Run()
+		})
+	})
+
+	t.Run("missing right case", func(t *testing.T) {
+		assert.PanicsWithError(t, "non exhaustive match: missing Right case", func() {
+			MatchEither[int](Left[error, string](errors.New("missing"))).
+				Left(func(err error) int { return -1 }).
+				Run()
+		})
+	})
+}
@@ -24,6 +24,7 @@ var ErrorNoneValue = errors.New("NoneValue presented")
 var ErrorSomeValue = errors.New("SomeValue presented")
 
 // Option : Option type
+// the zero value of Option is None, so every None of the same type is equal using == and maps to the same key
 type Option[A any] struct {
 	value A
 	t     Type
@@ -32,7 +33,7 @@ type Option[A any] struct {
 // NewOptional create new Option
 func NewOptional[A any](value A) Option[A] {
 	if utils.IsNilOrZeroValue(value) || utils.IsPtr(value) {
-		return Option[A]{t: NoneType}
+		return None[A]()
 	}
 	return Option[A]{value: value, t: SomeType}
 }
@@ -46,19 +47,45 @@ func Some[A any](value A) (Option[A], error) {
 	return Option[A]{value: value, t: SomeType}, nil
 }
 
-// None will create an Option of NoneType
+// None will create an Option of NoneType, which is the zero value of Option
 func None[A any]() Option[A] {
-	return Option[A]{t: NoneType}
+	return Option[A]{}
+}
+
+// FromOk create Option from the comma-ok idiom, Some if ok is True and the value passes NewOptional checks
+// e.g.
+//
+//	option := FromOk(cache.Get(key))
+func FromOk[A any](value A, ok bool) Option[A] {
+	if !ok {
+		return None[A]()
+	}
+	return NewOptional(value)
+}
+
+// FromMap create Option from a map lookup, Some if the key exists and None otherwise
+func FromMap[K comparable, V any](m map[K]V, key K) Option[V] {
+	value, ok := m[key]
+	return FromOk(value, ok)
+}
+
+// FromTypeAssert create Option from a type assertion, Some if value is of type A and None otherwise
+func FromTypeAssert[A any](value any) Option[A] {
+	v, ok := value.(A)
+	return FromOk(v, ok)
 }
 
 // GetType return Option Type
 func (o Option[A]) GetType() Type {
+	if o.t != SomeType {
+		return NoneType
+	}
 	return o.t
 }
 
 // IsNone validates if the Option of Type None
 func (o Option[A]) IsNone() bool {
-	return o.t == NoneType
+	return o.t != SomeType
 }
 
 // IsSome validates if the Option of Type Some
@@ -72,10 +99,9 @@ func (o Option[A]) Get() A {
 }
 
 // Apply applies function f and returns another Option after apply
+// please note that f receives the wrapped value as is, so for reference types such as slices and maps
+// changes made by f are visible to the original Option, use ApplyCopy to avoid that
 func (o Option[A]) Apply(f func(value A) A) Option[A] {
-	// TODO think about the case where the value is reference type such as slice, Map
-	//  as the original value will be update and is this behaviour needed
-	//  the alternative is to do deep copy for the reference types which requires reflection
 	if o.t == SomeType {
 		return Option[A]{
 			value: f(o.value),
@@ -85,6 +111,18 @@ func (o Option[A]) Apply(f func(value A) A) Option[A] {
 	return o
 }
 
+// ApplyCopy behaves like Apply while f receives a deep copy of the wrapped value
+// so the original Option is never changed, the copy is made using utils.DeepClone
+// which uses Clone for values that implement utils.Cloner
+func (o Option[A]) ApplyCopy(f func(value A) A) Option[A] {
+	if o.IsSome() {
+		return o.Apply(func(value A) A {
+			return f(utils.DeepClone(value))
+		})
+	}
+	return o
+}
+
 // Take return the wrapped value in the context of SomeType, and error in the context of NoneType
 func (o Option[A]) Take() (value A, err error) {
 	value = o.value
@@ -127,17 +165,56 @@ func (o Option[A]) OrElse(fn func() Option[A]) Option[A] {
 	return o
 }
 
+// Filter return the Option if it is SomeType and the value satisfies the predicate, otherwise None
+func (o Option[A]) Filter(fn utils.Predicate[A]) Option[A] {
+	if o.IsSome() && fn(o.value) {
+		return o
+	}
+	return None[A]()
+}
+
+// Exists return True if the Option is SomeType and the value satisfies the predicate
+func (o Option[A]) Exists(fn utils.Predicate[A]) bool {
+	return o.IsSome() && fn(o.value)
+}
+
+// ForAll return True if the Option is NoneType or the value satisfies the predicate
+func (o Option[A]) ForAll(fn utils.Predicate[A]) bool {
+	return o.IsNone() || fn(o.value)
+}
+
+// Contains return True if the Option is SomeType and its value equals value
+func Contains[A comparable](option Option[A], value A) bool {
+	return option.IsSome() && option.value == value
+}
+
+// OkOr return the wrapped value in the context of SomeType, and err in the context of NoneType
+// it behaves like Take while letting the caller decide on the error
+func (o Option[A]) OkOr(err error) (A, error) {
+	if o.IsNone() {
+		var zero A
+		return zero, err
+	}
+	return o.value, nil
+}
+
 // IfSome execute f() if the Option is SomeType
+// please note that f receives the wrapped value as is, use IfSomeCopy for reference types
 func (o Option[A]) IfSome(f func(value A) A) (A, error) {
-	// TODO think about the case where the value is reference type such as slice, Map
-	//  as the original value will be update and is this behaviour needed
-	//  the alternative is to do deep copy for the reference types which requires reflection
 	if o.IsSome() {
 		return f(o.value), nil
 	}
 	return o.value, ErrorNoneValue
 }
 
+// IfSomeCopy behaves like IfSome while f receives a deep copy of the wrapped value
+func (o Option[A]) IfSomeCopy(f func(value A) A) (A, error) {
+	if o.IsSome() {
+		return f(utils.DeepClone(o.value)), nil
+	}
+	return o.value, ErrorNoneValue
+}
+
 // IfNone execute f() if the Option is NoneType
 func (o Option[A]) IfNone(fn func() A) (A, error) {
 	if o.IsNone() {
@@ -152,6 +229,8 @@ func (o Option[T]) Unwrap() T {
 }
 
 // Flatten :Flatten an Option[Option[A]] --> Option[A]
+// this only applies when the wrapped value is exactly Option[A], otherwise the Option is returned unchanged
+// please use the package level Flatten which is type safe
 func (o Option[A]) Flatten() Option[A] {
 	// if the inner type is also an option Flatten it
 	if o, ok := any(o.Get()).(Option[A]); ok {
@@ -187,19 +266,30 @@ func Map[A, B any](option Option[A], mapper utils.Mapper[A, B]) Option[B] {
 	return someOpt
 }
 
+// Flatten :Flatten an Option[Option[A]] --> Option[A]
+// the result is Some only if both the outer and the inner Options are Some
+// deeper levels are flattened by applying Flatten multiple times
+// e.g.
+//
+//	Flatten(Flatten(Option[Option[Option[A]]])) => Option[A]
+func Flatten[A any](option Option[Option[A]]) Option[A] {
+	if option.IsNone() {
+		return None[A]()
+	}
+	return option.value
+}
+
 // OptionFlatMapperFn is a function that is applies on type A and return Option[B]
 type OptionFlatMapperFn[A, B any] func(A) Option[B]
 
 // FlatMap for Option[A] apply mapper function from A--> Option[B] and return Option[B]
-// This function could panic if the mapper is not applicable on A such as in the context of
-// Option[Option[Option[A]]
+// for nested Options such as Option[Option[Option[A]]] please use Flatten as many times as needed
 func (o Option[A]) FlatMap(fn OptionFlatMapperFn[A, any]) Option[any] {
 	return OptionFlatMap(o, fn)
 }
 
 // OptionFlatMap for Option[A] apply mapper function from A--> Option[B] and return Option[B]
-// This function could panic if the mapper is not applicable on A such as in the context of
-// Option[Option[Option[A]]
+// for nested Options such as Option[Option[Option[A]]] please use Flatten as many times as needed
 func OptionFlatMap[A, B any](option Option[A], mapper OptionFlatMapperFn[A, B]) Option[B] {
 	if option.IsNone() {
 		return None[B]()
@@ -226,3 +316,12 @@ func (o Option[A]) ToEither() Either[any, A] {
 	}
 	return Right[any, A](o.value)
 }
+
+// ToEitherWith Converts Option to Either if None => Left(left), if Some => Right
+// unlike ToEither the caller decides on the Left value that explains why the value is missing
+func ToEitherWith[L, A any](option Option[A], left L) Either[L, A] {
+	if option.IsNone() {
+		return Left[L, A](left)
+	}
+	return Either[L, A]{right: option.value, side: isRightSided}
+}
@@ -0,0 +1,74 @@
+// Package src ...
+package src
+
+// Zip combines 2 Options into an Option of Pair
+// the result is Some only if both Options are Some
+func Zip[A, B any](a Option[A], b Option[B]) Option[Pair[A, B]] {
+	if a.IsNone() || b.IsNone() {
+		return None[Pair[A, B]]()
+	}
+	return Option[Pair[A, B]]{value: NewPair(a.value, b.value), t: SomeType}
+}
+
+// Map2 applies fn on the values of 2 Options if both are Some and return Option of the result
+// the result is wrapped using NewOptional, so nil results will end up as None
+func Map2[A, B, C any](a Option[A], b Option[B], fn func(A, B) C) Option[C] {
+	if a.IsNone() || b.IsNone() {
+		return None[C]()
+	}
+	return NewOptional(fn(a.value, b.value))
+}
+
+// Map3 applies fn on the values of 3 Options if all of them are Some and return Option of the result
+func Map3[A, B, C, D any](a Option[A], b Option[B], c Option[C], fn func(A, B, C) D) Option[D] {
+	if a.IsNone() || b.IsNone() || c.IsNone() {
+		return None[D]()
+	}
+	return NewOptional(fn(a.value, b.value, c.value))
+}
+
+// Map4 applies fn on the values of 4 Options if all of them are Some and return Option of the result
+func Map4[A, B, C, D, E any](
+	a Option[A], b Option[B], c Option[C], d Option[D], fn func(A, B, C, D) E,
+) Option[E] {
+	if a.IsNone() || b.IsNone() || c.IsNone() || d.IsNone() {
+		return None[E]()
+	}
+	return NewOptional(fn(a.value, b.value, c.value, d.value))
+}
+
+// Map5 applies fn on the values of 5 Options if all of them are Some and return Option of the result
+func Map5[A, B, C, D, E, F any](
+	a Option[A], b Option[B], c Option[C], d Option[D], e Option[E], fn func(A, B, C, D, E) F,
+) Option[F] {
+	if a.IsNone() || b.IsNone() || c.IsNone() || d.IsNone() || e.IsNone() {
+		return None[F]()
+	}
+	return NewOptional(fn(a.value, b.value, c.value, d.value, e.value))
+}
+
+// Traverse applies fn on every element of values and collect the results
+// it returns Some of all the results if fn returns Some for all of them, and None on the first None
+// e.g.
+//
+//	Traverse([]string{"1", "2"}, parse) => Some([1, 2])
+//	Traverse([]string{"1", "x"}, parse) => None
+func Traverse[A, B any](values []A, fn OptionFlatMapperFn[A, B]) Option[[]B] {
+	out := make([]B, 0, len(values))
+	for _, value := range values {
+		option := fn(value)
+		if option.IsNone() {
+			return None[[]B]()
+		}
+		out = append(out, option.value)
+	}
+	return Option[[]B]{value: out, t: SomeType}
+}
+
+// Sequence converts a slice of Options into an Option of slice
+// it returns Some of all the values if all the Options are Some and None otherwise
+func Sequence[A any](options []Option[A]) Option[[]A] {
+	return Traverse(options, func(option Option[A]) Option[A] {
+		return option
+	})
+}
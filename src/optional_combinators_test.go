@@ -0,0 +1,126 @@
+package src
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"strconv"
+	"testing"
+)
+
+func TestZip(t *testing.T) {
+	t.Run("both some", func(t *testing.T) {
+		zipped := Zip(NewOptional("port"), NewOptional(8080))
+		assert.True(t, zipped.IsSome())
+		assert.Equal(t, NewPair("port", 8080), zipped.Get())
+	})
+
+	t.Run("any none", func(t *testing.T) {
+		assert.True(t, Zip(None[string](), NewOptional(8080)).IsNone())
+		assert.True(t, Zip(NewOptional("port"), None[int]()).IsNone())
+		assert.True(t, Zip(None[string](), None[int]()).IsNone())
+	})
+}
+
+func TestMapN(t *testing.T) {
+	host := NewOptional("localhost")
+	port := NewOptional(8080)
+	scheme := NewOptional("https")
+	path := NewOptional("api")
+	version := NewOptional(2)
+	none := None[string]()
+
+	t.Run("map2", func(t *testing.T) {
+		addr := Map2(host, port, func(h string, p int) string {
+			return fmt.Sprintf("%s:%d", h, p)
+		})
+		assert.Equal(t, NewOptional("localhost:8080"), addr)
+
+		assert.True(t, Map2(none, port, func(h string, p int) string {
+			return "unreachable"
+		}).IsNone())
+	})
+
+	t.Run("map2 nil result", func(t *testing.T) {
+		result := Map2(host, port, func(_ string, _ int) []int {
+			return nil
+		})
+		assert.True(t, result.IsNone())
+	})
+
+	t.Run("map3", func(t *testing.T) {
+		url := Map3(scheme, host, port, func(s, h string, p int) string {
+			return fmt.Sprintf("%s://%s:%d", s, h, p)
+		})
+		assert.Equal(t, NewOptional("https://localhost:8080"), url)
+
+		assert.True(t, Map3(scheme, none, port, func(s, h string, p int) string {
+			return "unreachable"
+		}).IsNone())
+	})
+
+	t.Run("map4", func(t *testing.T) {
+		url := Map4(scheme, host, port, path, func(s, h string, p int, pt string) string {
+			return fmt.Sprintf("%s://%s:%d/%s", s, h, p, pt)
+		})
+		assert.Equal(t, NewOptional("https://localhost:8080/api"), url)
+
+		assert.True(t, Map4(scheme, host, port, none, func(s, h string, p int, pt string) string {
+			return "unreachable"
+		}).IsNone())
+	})
+
+	t.Run("map5", func(t *testing.T) {
+		url := Map5(scheme, host, port, path, version, func(s, h string, p int, pt string, v int) string {
+			return fmt.Sprintf("%s://%s:%d/%s/v%d", s, h, p, pt, v)
+		})
+		assert.Equal(t, NewOptional("https://localhost:8080/api/v2"), url)
+
+		assert.True(t, Map5(scheme, host, port, path, None[int](), func(s, h string, p int, pt string, v int) string {
+			return "unreachable"
+		}).IsNone())
+	})
+}
+
+func TestTraverse(t *testing.T) {
+	parse := func(value string) Option[int] {
+		i, err := strconv.Atoi(value)
+		if err != nil {
+			return None[int]()
+		}
+		return NewOptional(i)
+	}
+
+	t.Run("all some", func(t *testing.T) {
+		result := Traverse([]string{"1", "2", "3"}, parse)
+		assert.True(t, result.IsSome())
+		assert.Equal(t, []int{1, 2, 3}, result.Get())
+	})
+
+	t.Run("short circuit on none", func(t *testing.T) {
+		calls := 0
+		result := Traverse([]string{"1", "x", "3"}, func(value string) Option[int] {
+			calls++
+			return parse(value)
+		})
+		assert.True(t, result.IsNone())
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		result := Traverse([]string{}, parse)
+		assert.True(t, result.IsSome())
+		assert.Empty(t, result.Get())
+	})
+}
+
+func TestSequence(t *testing.T) {
+	t.Run("all some", func(t *testing.T) {
+		result := Sequence([]Option[int]{NewOptional(1), NewOptional(2)})
+		assert.Equal(t, []int{1, 2}, result.Get())
+	})
+
+	t.Run("any none", func(t *testing.T) {
+		result := Sequence([]Option[int]{NewOptional(1), None[int]()})
+		assert.True(t, result.IsNone())
+	})
+}
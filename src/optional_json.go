@@ -0,0 +1,42 @@
+// Package src ...
+package src
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// jsonNull is the JSON representation of None
+var jsonNull = []byte("null")
+
+// MarshalJSON implements json.Marshaler
+// None is encoded as null and Some is encoded as the wrapped value
+func (o Option[A]) MarshalJSON() ([]byte, error) {
+	if o.IsNone() {
+		return jsonNull, nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+// null is decoded as None and any other value is decoded into A and wrapped using Some,
+// pointers such as Option[*A] are wrapped like SomePtr, so they survive a round trip
+// an omitted field keeps the zero value of the Option which is None as well
+func (o *Option[A]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		*o = None[A]()
+		return nil
+	}
+	var value A
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	option, err := someOrPtr(value)
+	*o = option
+	return err
+}
+
+// IsZero reports whether the Option is None, which is the zero value of Option
+func (o Option[A]) IsZero() bool {
+	return o.IsNone()
+}
@@ -0,0 +1,155 @@
+package src
+
+import (
+	"encoding/json"
+	"github.com/sghaida/fpv2/src/collections/dict"
+	"github.com/sghaida/fpv2/src/collections/list"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestOption_MarshalJSON(t *testing.T) {
+	type payload struct {
+		Name  Option[string] `json:"name"`
+		Age   Option[int]    `json:"age"`
+		Email Option[string] `json:"email"`
+	}
+
+	t.Run("some and none", func(t *testing.T) {
+		p := payload{
+			Name:  NewOptional("saddam"),
+			Age:   NewOptional(42),
+			Email: None[string](),
+		}
+		data, err := json.Marshal(p)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"name":"saddam","age":42,"email":null}`, string(data))
+	})
+
+	t.Run("zero value option", func(t *testing.T) {
+		data, err := json.Marshal(payload{})
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"name":null,"age":null,"email":null}`, string(data))
+	})
+
+	t.Run("struct value", func(t *testing.T) {
+		type inner struct {
+			ID int `json:"id"`
+		}
+		data, err := json.Marshal(NewOptional(inner{ID: 1}))
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"id":1}`, string(data))
+	})
+}
+
+func TestOption_UnmarshalJSON(t *testing.T) {
+	type payload struct {
+		Name  Option[string]   `json:"name"`
+		Age   Option[int]      `json:"age"`
+		Email Option[string]   `json:"email"`
+		Tags  Option[[]string] `json:"tags"`
+	}
+
+	t.Run("some, null and omitted", func(t *testing.T) {
+		var p payload
+		err := json.Unmarshal([]byte(`{"name":"saddam","email":null,"tags":["a","b"]}`), &p)
+		assert.NoError(t, err)
+
+		assert.True(t, p.Name.IsSome())
+		assert.Equal(t, "saddam", p.Name.Get())
+		assert.True(t, p.Email.IsNone())
+		assert.True(t, p.Age.IsNone())
+		assert.Equal(t, NoneType, p.Age.GetType())
+		assert.True(t, p.Tags.IsSome())
+		assert.Equal(t, []string{"a", "b"}, p.Tags.Get())
+	})
+
+	t.Run("null overrides some", func(t *testing.T) {
+		p := payload{Name: NewOptional("saddam")}
+		err := json.Unmarshal([]byte(`{"name":null}`), &p)
+		assert.NoError(t, err)
+		assert.True(t, p.Name.IsNone())
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		var p payload
+		err := json.Unmarshal([]byte(`{"age":"not a number"}`), &p)
+		assert.Error(t, err)
+		assert.True(t, p.Age.IsNone())
+	})
+
+	t.Run("pointer round trip", func(t *testing.T) {
+		type wrapper struct {
+			C Option[*int]
+		}
+		five := 5
+		some, err := SomePtr(&five)
+		assert.NoError(t, err)
+
+		data, err := json.Marshal(wrapper{C: some})
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"C":5}`, string(data))
+
+		var out wrapper
+		assert.NoError(t, json.Unmarshal(data, &out))
+		assert.True(t, out.C.IsSome())
+		assert.Equal(t, 5, *out.C.Get())
+
+		data, err = json.Marshal(wrapper{C: None[*int]()})
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"C":null}`, string(data))
+		assert.NoError(t, json.Unmarshal(data, &out))
+		assert.True(t, out.C.IsNone())
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		in := payload{Name: NewOptional("saddam"), Age: NewOptional(42)}
+		data, err := json.Marshal(in)
+		assert.NoError(t, err)
+
+		var out payload
+		assert.NoError(t, json.Unmarshal(data, &out))
+		assert.Equal(t, in.Name, out.Name)
+		assert.Equal(t, in.Age, out.Age)
+		assert.True(t, out.Email.IsNone())
+		assert.True(t, out.Tags.IsNone())
+	})
+}
+
+func TestOption_JSONNestedCollections(t *testing.T) {
+	t.Run("dict of options", func(t *testing.T) {
+		in := dict.NewDict[string, Option[int]]().
+			Add("some", NewOptional(10)).
+			Add("none", None[int]())
+		data, err := json.Marshal(in)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `{"some":10,"none":null}`, string(data))
+
+		out := dict.NewDict[string, Option[int]]()
+		assert.NoError(t, json.Unmarshal(data, &out))
+		assert.Equal(t, 2, out.Size())
+		assert.Equal(t, NewOptional(10), out["some"])
+		assert.True(t, out["none"].IsNone())
+	})
+
+	t.Run("list of options", func(t *testing.T) {
+		in := list.List[Option[string]]{NewOptional("a"), None[string](), NewOptional("c")}
+		data, err := json.Marshal(in)
+		assert.NoError(t, err)
+		assert.JSONEq(t, `["a",null,"c"]`, string(data))
+
+		var out list.List[Option[string]]
+		assert.NoError(t, json.Unmarshal(data, &out))
+		assert.Equal(t, 3, out.Size())
+		assert.Equal(t, "a", out[0].Get())
+		assert.True(t, out[1].IsNone())
+		assert.Equal(t, "c", out[2].Get())
+	})
+}
+
+func TestOption_IsZero(t *testing.T) {
+	var zero Option[int]
+	assert.True(t, zero.IsZero())
+	assert.True(t, None[int]().IsZero())
+	assert.False(t, NewOptional(1).IsZero())
+}
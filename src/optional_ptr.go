@@ -0,0 +1,43 @@
+// Package src ...
+package src
+
+import "reflect"
+
+// FromPtr create Option from the value referenced by ptr
+// nil pointers result in None, otherwise the referenced value is copied and wrapped using NewOptional
+func FromPtr[A any](ptr *A) Option[A] {
+	if ptr == nil {
+		return None[A]()
+	}
+	return NewOptional(*ptr)
+}
+
+// ToPtr return a pointer to a copy of the wrapped value in the context of SomeType and nil in the context of NoneType
+func (o Option[A]) ToPtr() *A {
+	if o.IsNone() {
+		return nil
+	}
+	value := o.value
+	return &value
+}
+
+// SomePtr will create Option of SomeType that holds the pointer itself in case it is not nil
+// and returns error with NoneValue in case it is nil
+// unlike Some and NewOptional which reject all pointers, SomePtr is an explicit opt-in for pointer values
+// such as Option[*Config], JSON and sql decoding wrap the decoded pointers the same way,
+// please note that Map and FlatMap still use Some so mapping to a pointer will result in None
+func SomePtr[A any](ptr *A) (Option[*A], error) {
+	if ptr == nil {
+		return None[*A](), ErrorNoneValue
+	}
+	return Option[*A]{value: ptr, t: SomeType}, nil
+}
+
+// someOrPtr wraps non nil pointers like SomePtr and any other value using Some
+// it is used by the decoders so that pointer Options survive a round trip
+func someOrPtr[A any](value A) (Option[A], error) {
+	if v := reflect.ValueOf(value); v.Kind() == reflect.Ptr && !v.IsNil() {
+		return Option[A]{value: value, t: SomeType}, nil
+	}
+	return Some(value)
+}
@@ -0,0 +1,86 @@
+package src
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type ptrTestConfig struct {
+	Host string
+	Port int
+}
+
+func TestFromPtr(t *testing.T) {
+	t.Run("nil pointer", func(t *testing.T) {
+		var cfg *ptrTestConfig
+		option := FromPtr(cfg)
+		assert.True(t, option.IsNone())
+	})
+
+	t.Run("non nil pointer", func(t *testing.T) {
+		cfg := &ptrTestConfig{Host: "localhost", Port: 8080}
+		option := FromPtr(cfg)
+		assert.True(t, option.IsSome())
+		assert.Equal(t, *cfg, option.Get())
+
+		// the option holds a copy of the referenced value
+		cfg.Port = 9090
+		assert.Equal(t, 8080, option.Get().Port)
+	})
+
+	t.Run("pointer to nil slice", func(t *testing.T) {
+		var values []int
+		assert.True(t, FromPtr(&values).IsNone())
+	})
+}
+
+func TestOption_ToPtr(t *testing.T) {
+	t.Run("none", func(t *testing.T) {
+		assert.Nil(t, None[int]().ToPtr())
+	})
+
+	t.Run("some", func(t *testing.T) {
+		option := NewOptional(ptrTestConfig{Host: "localhost", Port: 8080})
+		ptr := option.ToPtr()
+		assert.NotNil(t, ptr)
+		assert.Equal(t, option.Get(), *ptr)
+
+		// changing the pointer doesn't change the option
+		ptr.Port = 9090
+		assert.Equal(t, 8080, option.Get().Port)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		option := NewOptional("saddam")
+		assert.Equal(t, option, FromPtr(option.ToPtr()))
+	})
+}
+
+func TestSomePtr(t *testing.T) {
+	t.Run("nil pointer", func(t *testing.T) {
+		option, err := SomePtr[ptrTestConfig](nil)
+		assert.ErrorIs(t, err, ErrorNoneValue)
+		assert.True(t, option.IsNone())
+	})
+
+	t.Run("non nil pointer", func(t *testing.T) {
+		cfg := &ptrTestConfig{Host: "localhost", Port: 8080}
+		option, err := SomePtr(cfg)
+		assert.NoError(t, err)
+		assert.True(t, option.IsSome())
+		assert.Same(t, cfg, option.Get())
+
+		applied := option.Apply(func(value *ptrTestConfig) *ptrTestConfig {
+			return &ptrTestConfig{Host: value.Host, Port: 9090}
+		})
+		assert.True(t, applied.IsSome())
+		assert.Equal(t, 9090, applied.Get().Port)
+	})
+
+	t.Run("strict constructors are unchanged", func(t *testing.T) {
+		cfg := &ptrTestConfig{Host: "localhost", Port: 8080}
+		assert.True(t, NewOptional(cfg).IsNone())
+		_, err := Some(cfg)
+		assert.ErrorIs(t, err, ErrorNoneValue)
+	})
+}
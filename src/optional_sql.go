@@ -0,0 +1,151 @@
+// Package src ...
+package src
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// ErrorUnsupportedScan if the driver value can not be assigned to the Option type
+var ErrorUnsupportedScan = errors.New("unsupported scan")
+
+// Scan implements sql.Scanner
+// NULL is scanned as None, and any other value is converted to A and wrapped using Some
+// for pointer types such as Option[*string] the value is converted to the referenced type and wrapped like SomePtr
+// the supported driver types are string, int64, float64, bool, time.Time and []byte
+func (o *Option[A]) Scan(src any) error {
+	if src == nil {
+		*o = None[A]()
+		return nil
+	}
+	var value A
+	dest := reflect.ValueOf(&value).Elem()
+	if dest.Kind() == reflect.Ptr {
+		dest.Set(reflect.New(dest.Type().Elem()))
+		dest = dest.Elem()
+	}
+	if err := convertAssign(dest, src); err != nil {
+		*o = None[A]()
+		return err
+	}
+	option, err := someOrPtr(value)
+	*o = option
+	return err
+}
+
+// Value implements driver.Valuer
+// None is stored as NULL and Some is converted using the default driver parameter converter
+func (o Option[A]) Value() (driver.Value, error) {
+	if o.IsNone() {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(o.value)
+}
+
+// convertAssign copies the driver value src into the settable value dv
+// it covers the driver types and the conversions between them that are commonly needed for nullable columns
+func convertAssign(dv reflect.Value, src any) error {
+	sv := reflect.ValueOf(src)
+	// same type, e.g. time.Time => time.Time
+	if sv.Type().AssignableTo(dv.Type()) {
+		// copy bytes as the driver is allowed to reuse the buffer
+		if b, ok := src.([]byte); ok {
+			sv = reflect.ValueOf(append([]byte(nil), b...))
+		}
+		dv.Set(sv)
+		return nil
+	}
+
+	switch dv.Kind() {
+	case reflect.String:
+		switch s := src.(type) {
+		case string:
+			dv.SetString(s)
+			return nil
+		case []byte:
+			dv.SetString(string(s))
+			return nil
+		}
+	case reflect.Slice:
+		if dv.Type().Elem().Kind() == reflect.Uint8 {
+			switch s := src.(type) {
+			case string:
+				dv.SetBytes([]byte(s))
+				return nil
+			case []byte:
+				dv.SetBytes(append([]byte(nil), s...))
+				return nil
+			}
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if sv.Kind() == reflect.Int64 {
+			if dv.OverflowInt(sv.Int()) {
+				break
+			}
+			dv.SetInt(sv.Int())
+			return nil
+		}
+		if s, ok := asString(src); ok {
+			i, err := strconv.ParseInt(s, 10, dv.Type().Bits())
+			if err != nil {
+				return fmt.Errorf("%w: %v", ErrorUnsupportedScan, err)
+			}
+			dv.SetInt(i)
+			return nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if sv.Kind() == reflect.Int64 {
+			if sv.Int() < 0 || dv.OverflowUint(uint64(sv.Int())) {
+				break
+			}
+			dv.SetUint(uint64(sv.Int()))
+			return nil
+		}
+	case reflect.Float32, reflect.Float64:
+		switch sv.Kind() {
+		case reflect.Float64:
+			dv.SetFloat(sv.Float())
+			return nil
+		case reflect.Int64:
+			dv.SetFloat(float64(sv.Int()))
+			return nil
+		}
+		if s, ok := asString(src); ok {
+			f, err := strconv.ParseFloat(s, dv.Type().Bits())
+			if err != nil {
+				return fmt.Errorf("%w: %v", ErrorUnsupportedScan, err)
+			}
+			dv.SetFloat(f)
+			return nil
+		}
+	case reflect.Bool:
+		switch sv.Kind() {
+		case reflect.Bool:
+			dv.SetBool(sv.Bool())
+			return nil
+		case reflect.Int64:
+			dv.SetBool(sv.Int() != 0)
+			return nil
+		}
+	}
+
+	if sv.Type().ConvertibleTo(dv.Type()) && sv.Kind() == dv.Kind() {
+		dv.Set(sv.Convert(dv.Type()))
+		return nil
+	}
+	return fmt.Errorf("%w: converting driver value of type %T to %s", ErrorUnsupportedScan, src, dv.Type())
+}
+
+// asString returns the string representation of textual driver values
+func asString(src any) (string, bool) {
+	switch s := src.(type) {
+	case string:
+		return s, true
+	case []byte:
+		return string(s), true
+	}
+	return "", false
+}
@@ -0,0 +1,216 @@
+package src
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"testing"
+	"time"
+)
+
+// fakeDriver is a minimal database/sql driver that returns a single row with the configured values
+// and records the arguments passed to Exec
+type fakeDriver struct {
+	row  []driver.Value
+	args []driver.Value
+}
+
+type fakeConn struct{ d *fakeDriver }
+
+type fakeStmt struct{ d *fakeDriver }
+
+type fakeRows struct {
+	row  []driver.Value
+	done bool
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error)        { return &fakeConn{d: d}, nil }
+func (c *fakeConn) Prepare(string) (driver.Stmt, error)       { return &fakeStmt{d: c.d}, nil }
+func (c *fakeConn) Close() error                              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)                 { return nil, errors.New("not supported") }
+func (s *fakeStmt) Close() error                              { return nil }
+func (s *fakeStmt) NumInput() int                             { return -1 }
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) { return &fakeRows{row: s.d.row}, nil }
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.d.args = args
+	return driver.RowsAffected(1), nil
+}
+
+func (r *fakeRows) Close() error { return nil }
+
+func (r *fakeRows) Columns() []string {
+	cols := make([]string, len(r.row))
+	for i := range cols {
+		cols[i] = "col"
+	}
+	return cols
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	copy(dest, r.row)
+	r.done = true
+	return nil
+}
+
+var testDriver = &fakeDriver{}
+
+func init() {
+	sql.Register("fpv2-fake", testDriver)
+}
+
+func openFakeDB(t *testing.T, row ...driver.Value) *sql.DB {
+	t.Helper()
+	testDriver.row = row
+	db, err := sql.Open("fpv2-fake", "")
+	assert.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	return db
+}
+
+func TestOption_Scan(t *testing.T) {
+	now := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("driver values", func(t *testing.T) {
+		db := openFakeDB(t, "saddam", int64(42), 3.14, true, now, []byte("raw"))
+
+		var (
+			name   Option[string]
+			age    Option[int64]
+			score  Option[float64]
+			active Option[bool]
+			seen   Option[time.Time]
+			blob   Option[[]byte]
+		)
+		err := db.QueryRow("select").Scan(&name, &age, &score, &active, &seen, &blob)
+		assert.NoError(t, err)
+
+		assert.Equal(t, NewOptional("saddam"), name)
+		assert.Equal(t, NewOptional(int64(42)), age)
+		assert.Equal(t, NewOptional(3.14), score)
+		assert.Equal(t, NewOptional(true), active)
+		assert.Equal(t, NewOptional(now), seen)
+		assert.Equal(t, []byte("raw"), blob.Get())
+	})
+
+	t.Run("null values", func(t *testing.T) {
+		db := openFakeDB(t, nil, nil, nil, nil, nil, nil)
+
+		var (
+			name   = NewOptional("previous")
+			age    Option[int64]
+			score  Option[float64]
+			active Option[bool]
+			seen   Option[time.Time]
+			blob   Option[[]byte]
+		)
+		err := db.QueryRow("select").Scan(&name, &age, &score, &active, &seen, &blob)
+		assert.NoError(t, err)
+
+		assert.True(t, name.IsNone())
+		assert.True(t, age.IsNone())
+		assert.True(t, score.IsNone())
+		assert.True(t, active.IsNone())
+		assert.True(t, seen.IsNone())
+		assert.True(t, blob.IsNone())
+	})
+
+	t.Run("conversions", func(t *testing.T) {
+		db := openFakeDB(t, []byte("saddam"), int64(7), "12", int64(2), int64(1), "bytes")
+
+		var (
+			name   Option[string]
+			small  Option[int32]
+			parsed Option[int]
+			ratio  Option[float32]
+			flag   Option[bool]
+			blob   Option[[]byte]
+		)
+		err := db.QueryRow("select").Scan(&name, &small, &parsed, &ratio, &flag, &blob)
+		assert.NoError(t, err)
+
+		assert.Equal(t, "saddam", name.Get())
+		assert.Equal(t, int32(7), small.Get())
+		assert.Equal(t, 12, parsed.Get())
+		assert.Equal(t, float32(2), ratio.Get())
+		assert.True(t, flag.Get())
+		assert.Equal(t, []byte("bytes"), blob.Get())
+	})
+
+	t.Run("unsupported conversion", func(t *testing.T) {
+		var age Option[int]
+		err := age.Scan(true)
+		assert.ErrorIs(t, err, ErrorUnsupportedScan)
+		assert.True(t, age.IsNone())
+
+		var small Option[int8]
+		err = small.Scan(int64(1000))
+		assert.ErrorIs(t, err, ErrorUnsupportedScan)
+		assert.True(t, small.IsNone())
+
+		var parsed Option[int]
+		err = parsed.Scan("abc")
+		assert.ErrorIs(t, err, ErrorUnsupportedScan)
+	})
+
+	t.Run("pointer values are wrapped like SomePtr", func(t *testing.T) {
+		var ptr Option[*string]
+		assert.NoError(t, ptr.Scan([]byte("saddam")))
+		assert.True(t, ptr.IsSome())
+		assert.Equal(t, "saddam", *ptr.Get())
+
+		var number Option[*int64]
+		assert.NoError(t, number.Scan(int64(10)))
+		assert.Equal(t, int64(10), *number.Get())
+
+		assert.NoError(t, ptr.Scan(nil))
+		assert.True(t, ptr.IsNone())
+
+		var invalid Option[*int]
+		assert.ErrorIs(t, invalid.Scan("abc"), ErrorUnsupportedScan)
+		assert.True(t, invalid.IsNone())
+	})
+}
+
+func TestOption_Value(t *testing.T) {
+	now := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("driver values", func(t *testing.T) {
+		tt := []struct {
+			name     string
+			valuer   driver.Valuer
+			expected driver.Value
+		}{
+			{name: "string", valuer: NewOptional("saddam"), expected: "saddam"},
+			{name: "int", valuer: NewOptional(42), expected: int64(42)},
+			{name: "float", valuer: NewOptional(3.14), expected: 3.14},
+			{name: "bool", valuer: NewOptional(true), expected: true},
+			{name: "time", valuer: NewOptional(now), expected: now},
+			{name: "bytes", valuer: NewOptional([]byte("raw")), expected: []byte("raw")},
+			{name: "none", valuer: None[string](), expected: nil},
+		}
+		for _, tc := range tt {
+			t.Run(tc.name, func(t *testing.T) {
+				value, err := tc.valuer.Value()
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, value)
+			})
+		}
+	})
+
+	t.Run("exec arguments", func(t *testing.T) {
+		db := openFakeDB(t)
+		_, err := db.Exec("insert", NewOptional("saddam"), None[int64]())
+		assert.NoError(t, err)
+		assert.Equal(t, []driver.Value{"saddam", nil}, testDriver.args)
+	})
+
+	t.Run("unsupported value", func(t *testing.T) {
+		_, err := NewOptional(struct{ name string }{name: "saddam"}).Value()
+		assert.Error(t, err)
+	})
+}
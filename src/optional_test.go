@@ -2,6 +2,7 @@ package src
 
 import (
 	"crypto/sha1"
+	"errors"
 	"fmt"
 	"github.com/sghaida/fpv2/src/utils"
 	"github.com/stretchr/testify/assert"
@@ -66,14 +67,15 @@ func TestNewOptional(t *testing.T) {
 			if option.t == SomeType {
 				assert.Equal(t, option.Unwrap(), tc.value)
 			}
-			assert.Equal(t, option.t, tc.expectedType)
+			assert.Equal(t, option.GetType(), tc.expectedType)
 		})
 	}
 }
 
 func TestNone(t *testing.T) {
 	option := None[int]()
-	assert.Equal(t, option.t, NoneType)
+	assert.Equal(t, option.GetType(), NoneType)
+	assert.Equal(t, Option[int]{}, option)
 }
 
 func TestSome(t *testing.T) {
@@ -140,7 +142,7 @@ func TestSome(t *testing.T) {
 			}
 			assert.Equal(t, option.IsSome(), true)
 			assert.Equal(t, option.Get(), tc.value)
-			assert.Equal(t, option.t, tc.expectedType)
+			assert.Equal(t, option.GetType(), tc.expectedType)
 			assert.Equal(t, option.GetType(), SomeType)
 		})
 	}
@@ -1217,3 +1219,256 @@ func TestOption_ToEither(t *testing.T) {
 	assert.IsType(t, 10, rv)
 	assert.Equal(t, rv, 10)
 }
+
+func TestOption_Filter(t *testing.T) {
+	isEven := func(value int) bool { return value%2 == 0 }
+
+	assert.Equal(t, NewOptional(10), NewOptional(10).Filter(isEven))
+	assert.True(t, NewOptional(11).Filter(isEven).IsNone())
+	assert.True(t, None[int]().Filter(isEven).IsNone())
+}
+
+func TestOption_Exists(t *testing.T) {
+	isEven := func(value int) bool { return value%2 == 0 }
+
+	assert.True(t, NewOptional(10).Exists(isEven))
+	assert.False(t, NewOptional(11).Exists(isEven))
+	assert.False(t, None[int]().Exists(isEven))
+}
+
+func TestOption_ForAll(t *testing.T) {
+	isEven := func(value int) bool { return value%2 == 0 }
+
+	assert.True(t, NewOptional(10).ForAll(isEven))
+	assert.False(t, NewOptional(11).ForAll(isEven))
+	assert.True(t, None[int]().ForAll(isEven))
+}
+
+func TestContains(t *testing.T) {
+	assert.True(t, Contains(NewOptional("saddam"), "saddam"))
+	assert.False(t, Contains(NewOptional("saddam"), "ghaida"))
+	assert.False(t, Contains(None[string](), ""))
+}
+
+func TestOption_OkOr(t *testing.T) {
+	errMissingPort := errors.New("port is not configured")
+
+	value, err := NewOptional(8080).OkOr(errMissingPort)
+	assert.NoError(t, err)
+	assert.Equal(t, 8080, value)
+
+	value, err = None[int]().OkOr(errMissingPort)
+	assert.ErrorIs(t, err, errMissingPort)
+	assert.Equal(t, 0, value)
+}
+
+func TestToEitherWith(t *testing.T) {
+	t.Run("none", func(t *testing.T) {
+		either := ToEitherWith(None[int](), "port is not configured")
+		assert.IsType(t, Either[string, int]{}, either)
+		assert.True(t, either.IsLeft())
+		left, err := either.TakeLeft()
+		assert.NoError(t, err)
+		assert.Equal(t, "port is not configured", left)
+	})
+
+	t.Run("some", func(t *testing.T) {
+		either := ToEitherWith(NewOptional(8080), "port is not configured")
+		assert.True(t, either.IsRight())
+		right, err := either.TakeRight()
+		assert.NoError(t, err)
+		assert.Equal(t, 8080, right)
+	})
+}
+
+// nestOptions wraps every option in Some and adds None, to produce all the shapes of the next nesting level
+func nestOptions[A any](options []Option[A]) []Option[Option[A]] {
+	out := []Option[Option[A]]{None[Option[A]]()}
+	for _, option := range options {
+		out = append(out, NewOptional(option))
+	}
+	return out
+}
+
+func TestFlatten(t *testing.T) {
+	t.Run("2 levels", func(t *testing.T) {
+		assert.Equal(t, NewOptional(10), Flatten(NewOptional(NewOptional(10))))
+		assert.True(t, Flatten(NewOptional(None[int]())).IsNone())
+		assert.True(t, Flatten(None[Option[int]]()).IsNone())
+	})
+
+	t.Run("arbitrary depth", func(t *testing.T) {
+		depth1 := []Option[int]{None[int](), NewOptional(10)}
+		depth2 := nestOptions(depth1)
+		depth3 := nestOptions(depth2)
+		depth4 := nestOptions(depth3)
+
+		for _, option := range depth4 {
+			flat := Flatten(Flatten(Flatten(option)))
+			allSome := option.IsSome() && option.Get().IsSome() &&
+				option.Get().Get().IsSome() && option.Get().Get().Get().IsSome()
+			assert.Equal(t, allSome, flat.IsSome())
+			if allSome {
+				assert.Equal(t, 10, flat.Get())
+			}
+		}
+	})
+
+	t.Run("monad laws", func(t *testing.T) {
+		pure := func(value int) Option[int] { return NewOptional(value) }
+		half := func(value int) Option[int] {
+			if value%2 != 0 {
+				return None[int]()
+			}
+			return NewOptional(value / 2)
+		}
+		positive := func(value int) Option[int] {
+			if value <= 0 {
+				return None[int]()
+			}
+			return NewOptional(value)
+		}
+
+		for _, value := range []int{-4, -1, 1, 2, 4, 8} {
+			m := NewOptional(value)
+			// left identity
+			assert.Equal(t, half(value), OptionFlatMap(pure(value), half))
+			// right identity
+			assert.Equal(t, m, OptionFlatMap(m, pure))
+			// associativity
+			assert.Equal(t,
+				OptionFlatMap(OptionFlatMap(m, half), positive),
+				OptionFlatMap(m, func(v int) Option[int] { return OptionFlatMap(half(v), positive) }),
+			)
+		}
+	})
+
+	t.Run("flatten laws at arbitrary depth", func(t *testing.T) {
+		depth1 := []Option[int]{None[int](), NewOptional(10)}
+		depth2 := nestOptions(depth1)
+		depth3 := nestOptions(depth2)
+		depth4 := nestOptions(depth3)
+
+		for _, option := range depth3 {
+			// Flatten . Flatten == Flatten . Map(Flatten)
+			assert.Equal(t, Flatten(Flatten(option)), Flatten(Map(option, Flatten[int])))
+			// Flatten . pure == id
+			assert.Equal(t, option, Flatten(NewOptional(option)))
+			// Flatten . Map(pure) == id
+			assert.Equal(t, option, Flatten(Map(option, func(v Option[Option[int]]) Option[Option[Option[int]]] {
+				return NewOptional(v)
+			})))
+		}
+		for _, option := range depth4 {
+			assert.Equal(t,
+				Flatten(Flatten(Flatten(option))),
+				Flatten(Flatten(Map(option, Flatten[Option[int]]))),
+			)
+		}
+	})
+}
+
+type copyTestSettings struct {
+	values []string
+}
+
+func (s copyTestSettings) Clone() copyTestSettings {
+	values := make([]string, len(s.values))
+	copy(values, s.values)
+	return copyTestSettings{values: values}
+}
+
+func TestOption_ApplyCopy(t *testing.T) {
+	t.Run("slice", func(t *testing.T) {
+		original := []int{1, 2, 3}
+		option := NewOptional(original)
+		applied := option.ApplyCopy(func(value []int) []int {
+			value[0] = 100
+			return value
+		})
+		assert.Equal(t, []int{100, 2, 3}, applied.Get())
+		assert.Equal(t, []int{1, 2, 3}, option.Get())
+		assert.Equal(t, []int{1, 2, 3}, original)
+	})
+
+	t.Run("map", func(t *testing.T) {
+		option := NewOptional(map[string][]int{"a": {1}})
+		applied := option.ApplyCopy(func(value map[string][]int) map[string][]int {
+			value["a"][0] = 100
+			value["b"] = []int{2}
+			return value
+		})
+		assert.Equal(t, map[string][]int{"a": {100}, "b": {2}}, applied.Get())
+		assert.Equal(t, map[string][]int{"a": {1}}, option.Get())
+	})
+
+	t.Run("cloner", func(t *testing.T) {
+		option := NewOptional(copyTestSettings{values: []string{"a"}})
+		applied := option.ApplyCopy(func(value copyTestSettings) copyTestSettings {
+			value.values[0] = "b"
+			return value
+		})
+		assert.Equal(t, "b", applied.Get().values[0])
+		assert.Equal(t, "a", option.Get().values[0])
+	})
+
+	t.Run("apply shares the value", func(t *testing.T) {
+		option := NewOptional([]int{1, 2, 3})
+		option.Apply(func(value []int) []int {
+			value[0] = 100
+			return value
+		})
+		assert.Equal(t, []int{100, 2, 3}, option.Get())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		applied := None[[]int]().ApplyCopy(func(value []int) []int {
+			return []int{1}
+		})
+		assert.True(t, applied.IsNone())
+	})
+}
+
+func TestOption_IfSomeCopy(t *testing.T) {
+	t.Run("some", func(t *testing.T) {
+		option := NewOptional(map[string]int{"a": 1})
+		value, err := option.IfSomeCopy(func(value map[string]int) map[string]int {
+			value["a"] = 100
+			return value
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]int{"a": 100}, value)
+		assert.Equal(t, map[string]int{"a": 1}, option.Get())
+	})
+
+	t.Run("none", func(t *testing.T) {
+		_, err := None[map[string]int]().IfSomeCopy(func(value map[string]int) map[string]int {
+			return value
+		})
+		assert.ErrorIs(t, err, ErrorNoneValue)
+	})
+}
+
+func TestFromOk(t *testing.T) {
+	assert.Equal(t, NewOptional(10), FromOk(10, true))
+	assert.True(t, FromOk(10, false).IsNone())
+	assert.True(t, FromOk([]int(nil), true).IsNone())
+}
+
+func TestFromMap(t *testing.T) {
+	m := map[string]int{"a": 1, "zero": 0}
+
+	assert.Equal(t, NewOptional(1), FromMap(m, "a"))
+	assert.Equal(t, NewOptional(0), FromMap(m, "zero"))
+	assert.True(t, FromMap(m, "b").IsNone())
+	assert.True(t, FromMap[string, int](nil, "a").IsNone())
+}
+
+func TestFromTypeAssert(t *testing.T) {
+	var value any = "saddam"
+
+	assert.Equal(t, NewOptional("saddam"), FromTypeAssert[string](value))
+	assert.True(t, FromTypeAssert[int](value).IsNone())
+	assert.True(t, FromTypeAssert[string](nil).IsNone())
+	assert.Equal(t, NewOptional[fmt.Stringer](NewPair(1, 2)), FromTypeAssert[fmt.Stringer](NewPair(1, 2)))
+}
@@ -0,0 +1,26 @@
+// Package src ...
+package src
+
+import "fmt"
+
+// Pair holds 2 values of possibly different types
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// NewPair creates a Pair from first and second
+func NewPair[A, B any](first A, second B) Pair[A, B] {
+	return Pair[A, B]{First: first, Second: second}
+}
+
+// Unpack return the values of the Pair
+// first, second := p.Unpack()
+func (p Pair[A, B]) Unpack() (A, B) {
+	return p.First, p.Second
+}
+
+// String return String representation of the Pair
+func (p Pair[A, B]) String() string {
+	return fmt.Sprintf("(%v, %v)", p.First, p.Second)
+}
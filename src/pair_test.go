@@ -0,0 +1,18 @@
+package src
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestNewPair(t *testing.T) {
+	p := NewPair("saddam", 42)
+	assert.Equal(t, "saddam", p.First)
+	assert.Equal(t, 42, p.Second)
+
+	first, second := p.Unpack()
+	assert.Equal(t, "saddam", first)
+	assert.Equal(t, 42, second)
+
+	assert.Equal(t, "(saddam, 42)", p.String())
+}
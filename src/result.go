@@ -0,0 +1,161 @@
+// Package src ...
+package src
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Result is an Either that holds an error on the Left and a value of type T on the Right
+// all the Either operations are available on Result, along with error specific operations
+// such as Wrap, Is and As, and Unpack which returns the familiar (T, error).
+// it wraps Either[error, T] instead of being an alias of it, since generic type aliases require Go 1.24,
+// use ToEither to get the Either and Ok, Err or Result{Either: either} to get back a Result.
+// the chaining operations such as Recover, Ensure and OrElse are redefined to return Result,
+// the rest of the promoted Either operations return Either[error, T]
+type Result[T any] struct {
+	Either[error, T]
+}
+
+// Ok create Result from value, it behaves like Right
+// so nil values and pointers result in a Left holding ErrorLeftValue
+func Ok[T any](value T) Result[T] {
+	return Result[T]{Either: Right[error, T](value)}
+}
+
+// Err create Result from err, nil errors are replaced with ErrorLeftValue
+func Err[T any](err error) Result[T] {
+	if err == nil {
+		err = ErrorLeftValue
+	}
+	return Result[T]{Either: Left[error, T](err)}
+}
+
+// ToEither return the underlying Either
+func (r Result[T]) ToEither() Either[error, T] {
+	return r.Either
+}
+
+// ResultOf create Result from the value, err idiom, Err if err is not nil and Ok otherwise
+// err decides the side, so pointers, nil and zero values are kept as is and Unpack return the same pair
+// e.g.
+//
+//	result := ResultOf(strconv.Atoi(value))
+func ResultOf[T any](value T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+	return Result[T]{Either: RightUnchecked[error, T](value)}
+}
+
+// Unpack return the Result as (value, error) following the Go idioms
+// value, err := result.Unpack()
+func (r Result[T]) Unpack() (T, error) {
+	if r.IsLeft() {
+		var zero T
+		return zero, r.left
+	}
+	return r.right, nil
+}
+
+// Wrap adds context to the error in the context of Left, the original error is kept in the chain
+// so errors.Is and errors.As still apply, Right is returned unchanged
+func (r Result[T]) Wrap(msg string) Result[T] {
+	if r.IsLeft() {
+		return Err[T](fmt.Errorf("%s: %w", msg, r.left))
+	}
+	return r
+}
+
+// Is return True if the Result is Left and its error matches target using errors.Is
+func (r Result[T]) Is(target error) bool {
+	return r.IsLeft() && errors.Is(r.left, target)
+}
+
+// As return True if the Result is Left and its error matches target using errors.As
+// in which case target is set to the matching error
+func (r Result[T]) As(target any) bool {
+	return r.IsLeft() && errors.As(r.left, target)
+}
+
+// Or return the Result if Ok or result if not
+func (r Result[T]) Or(result Result[T]) Result[T] {
+	return Result[T]{Either: r.Either.Or(result.Either)}
+}
+
+// OrElse return the Result if Ok or the result of fn if not
+func (r Result[T]) OrElse(fn func() Result[T]) Result[T] {
+	if r.IsLeft() {
+		return fn()
+	}
+	return r
+}
+
+// LeftMap applies mapper on the error, and return the Result unchanged if Ok
+func (r Result[T]) LeftMap(mapper func(error) error) Result[T] {
+	return Result[T]{Either: r.Either.LeftMap(mapper)}
+}
+
+// Ensure return Err(err) if the value doesn't satisfy the predicate, and the Result unchanged otherwise
+func (r Result[T]) Ensure(fn func(T) bool, err error) Result[T] {
+	return Result[T]{Either: r.Either.Ensure(fn, err)}
+}
+
+// FilterOrElse behaves like Ensure while the error is built from the value using orElse
+func (r Result[T]) FilterOrElse(fn func(T) bool, orElse func(T) error) Result[T] {
+	return Result[T]{Either: r.Either.FilterOrElse(fn, orElse)}
+}
+
+// Recover return Ok of applying fn on the error, and the Result unchanged if Ok
+func (r Result[T]) Recover(fn func(error) T) Result[T] {
+	return Result[T]{Either: r.Either.Recover(fn)}
+}
+
+// RecoverWith return the result of applying fn on the error, and the Result unchanged if Ok
+func (r Result[T]) RecoverWith(fn func(error) Result[T]) Result[T] {
+	if r.IsLeft() {
+		return fn(r.left)
+	}
+	return r
+}
+
+// Tap applies the side effect fn on the value if Ok and return the Result unchanged
+func (r Result[T]) Tap(fn func(T)) Result[T] {
+	return Result[T]{Either: r.Either.Tap(fn)}
+}
+
+// TapLeft applies the side effect fn on the error if Err and return the Result unchanged
+func (r Result[T]) TapLeft(fn func(error)) Result[T] {
+	return Result[T]{Either: r.Either.TapLeft(fn)}
+}
+
+// ResultMap applies mapper on the value of Ok and return Err unchanged
+func ResultMap[T, U any](result Result[T], mapper func(T) U) Result[U] {
+	return Result[U]{Either: MapRight(result.Either, mapper)}
+}
+
+// ResultFlatMap applies fn on the value of Ok and return its Result, Err is returned unchanged
+func ResultFlatMap[T, U any](result Result[T], fn func(T) Result[U]) Result[U] {
+	if result.IsLeft() {
+		return Err[U](result.left)
+	}
+	return fn(result.right)
+}
+
+// JoinResults combines the Results into a Result of all the values if all of them are Ok
+// otherwise it returns Err that joins all the errors using errors.Join
+func JoinResults[T any](results ...Result[T]) Result[[]T] {
+	values := make([]T, 0, len(results))
+	var errs []error
+	for _, result := range results {
+		if result.IsLeft() {
+			errs = append(errs, result.left)
+			continue
+		}
+		values = append(values, result.right)
+	}
+	if len(errs) > 0 {
+		return Err[[]T](errors.Join(errs...))
+	}
+	return Result[[]T]{Either: RightUnchecked[error](values)}
+}
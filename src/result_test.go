@@ -0,0 +1,212 @@
+package src
+
+import (
+	"errors"
+	"fmt"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"strconv"
+	"testing"
+)
+
+func TestOk(t *testing.T) {
+	t.Run("value", func(t *testing.T) {
+		result := Ok(10)
+		assert.True(t, result.IsRight())
+		value, err := result.Take()
+		assert.NoError(t, err)
+		assert.Equal(t, 10, value)
+	})
+
+	t.Run("nil value", func(t *testing.T) {
+		result := Ok[[]int](nil)
+		assert.True(t, result.IsLeft())
+		left, _ := result.TakeLeft()
+		assert.Equal(t, ErrorLeftValue, left)
+	})
+}
+
+func TestErr(t *testing.T) {
+	errMissing := errors.New("missing")
+	result := Err[int](errMissing)
+	assert.True(t, result.IsLeft())
+	left, _ := result.TakeLeft()
+	assert.Equal(t, errMissing, left)
+
+	result = Err[int](nil)
+	left, _ = result.TakeLeft()
+	assert.Equal(t, ErrorLeftValue, left)
+}
+
+func TestResult_ToEither(t *testing.T) {
+	assert.Equal(t, Right[error, int](10), Ok(10).ToEither())
+}
+
+type resultTestError struct {
+	code int
+}
+
+func (e *resultTestError) Error() string {
+	return fmt.Sprintf("code %d", e.code)
+}
+
+func TestResultOf(t *testing.T) {
+	ok := ResultOf(strconv.Atoi("10"))
+	assert.True(t, ok.IsRight())
+
+	failed := ResultOf(strconv.Atoi("x"))
+	assert.True(t, failed.IsLeft())
+	assert.True(t, failed.Is(strconv.ErrSyntax))
+
+	zero := ResultOf(strconv.Atoi("0"))
+	assert.True(t, zero.IsRight())
+
+	file, err := os.Open(os.DevNull)
+	assert.NoError(t, err)
+	defer file.Close()
+	value, err := ResultOf(file, err).Unpack()
+	assert.NoError(t, err)
+	assert.Same(t, file, value)
+
+	var values []int
+	nilSlice, err := ResultOf(values, nil).Unpack()
+	assert.NoError(t, err)
+	assert.Nil(t, nilSlice)
+}
+
+func TestResult_Unpack(t *testing.T) {
+	value, err := Ok(10).Unpack()
+	assert.NoError(t, err)
+	assert.Equal(t, 10, value)
+
+	errMissing := errors.New("missing")
+	value, err = Err[int](errMissing).Unpack()
+	assert.ErrorIs(t, err, errMissing)
+	assert.Equal(t, 0, value)
+}
+
+func TestResult_Wrap(t *testing.T) {
+	errMissing := errors.New("missing")
+
+	wrapped := Err[int](errMissing).Wrap("loading config").Wrap("starting server")
+	_, err := wrapped.Unpack()
+	assert.EqualError(t, err, "starting server: loading config: missing")
+	assert.True(t, wrapped.Is(errMissing))
+
+	assert.Equal(t, Ok(10), Ok(10).Wrap("loading config"))
+}
+
+func TestResult_Is(t *testing.T) {
+	errMissing := errors.New("missing")
+
+	assert.True(t, Err[int](errMissing).Is(errMissing))
+	assert.False(t, Err[int](errMissing).Is(errors.New("missing")))
+	assert.False(t, Ok(10).Is(errMissing))
+}
+
+func TestResult_As(t *testing.T) {
+	result := Err[int](&resultTestError{code: 404}).Wrap("fetching user")
+
+	var target *resultTestError
+	assert.True(t, result.As(&target))
+	assert.Equal(t, 404, target.code)
+
+	var other *strconv.NumError
+	assert.False(t, result.As(&other))
+	assert.False(t, Ok(10).As(&target))
+}
+
+func TestResultMap(t *testing.T) {
+	mapped := ResultMap(Ok(10), strconv.Itoa)
+	value, err := mapped.Unpack()
+	assert.NoError(t, err)
+	assert.Equal(t, "10", value)
+
+	errMissing := errors.New("missing")
+	_, err = ResultMap(Err[int](errMissing), strconv.Itoa).Unpack()
+	assert.ErrorIs(t, err, errMissing)
+}
+
+func TestResultFlatMap(t *testing.T) {
+	parse := func(value string) Result[int] {
+		return ResultOf(strconv.Atoi(value))
+	}
+
+	value, err := ResultFlatMap(Ok("10"), parse).Unpack()
+	assert.NoError(t, err)
+	assert.Equal(t, 10, value)
+
+	_, err = ResultFlatMap(Ok("x"), parse).Unpack()
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+
+	errMissing := errors.New("missing")
+	_, err = ResultFlatMap(Err[string](errMissing), parse).Unpack()
+	assert.ErrorIs(t, err, errMissing)
+}
+
+func TestJoinResults(t *testing.T) {
+	values, err := JoinResults(Ok(1), Ok(2)).Unpack()
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, values)
+
+	errA := errors.New("a")
+	errB := errors.New("b")
+	joined := JoinResults(Ok(1), Err[int](errA), Err[int](errB))
+	assert.True(t, joined.Is(errA))
+	assert.True(t, joined.Is(errB))
+	_, err = joined.Unpack()
+	assert.EqualError(t, err, "a\nb")
+
+	values, err = JoinResults[int]().Unpack()
+	assert.NoError(t, err)
+	assert.Empty(t, values)
+}
+
+func TestResult_Chaining(t *testing.T) {
+	errMissing := errors.New("missing")
+	errNegative := errors.New("negative")
+	positive := func(i int) bool { return i > 0 }
+
+	t.Run("stays a Result", func(t *testing.T) {
+		var tapped []string
+		var result Result[int] = Err[int](errMissing).
+			TapLeft(func(err error) { tapped = append(tapped, err.Error()) }).
+			Recover(func(error) int { return -1 }).
+			Ensure(positive, errNegative).
+			RecoverWith(func(err error) Result[int] { return Ok(10) }).
+			Tap(func(i int) { tapped = append(tapped, strconv.Itoa(i)) })
+
+		value, err := result.Unpack()
+		assert.NoError(t, err)
+		assert.Equal(t, 10, value)
+		assert.Equal(t, []string{"missing", "10"}, tapped)
+	})
+
+	t.Run("or", func(t *testing.T) {
+		assert.Equal(t, Ok(1), Ok(1).Or(Ok(2)))
+		assert.Equal(t, Ok(2), Err[int](errMissing).Or(Ok(2)))
+		assert.Equal(t, Ok(2), Err[int](errMissing).OrElse(func() Result[int] { return Ok(2) }))
+		assert.Equal(t, Ok(1), Ok(1).OrElse(func() Result[int] { return Ok(2) }))
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		result := Ok(-1).FilterOrElse(positive, func(i int) error { return fmt.Errorf("%d: %w", i, errNegative) })
+		assert.True(t, result.Is(errNegative))
+
+		wrapped := result.LeftMap(func(err error) error { return fmt.Errorf("validating: %w", err) })
+		_, err := wrapped.Unpack()
+		assert.EqualError(t, err, "validating: -1: negative")
+	})
+
+	t.Run("recover with nil", func(t *testing.T) {
+		values, err := Err[[]int](errMissing).Recover(func(error) []int { return nil }).Unpack()
+		assert.NoError(t, err)
+		assert.Nil(t, values)
+	})
+
+	t.Run("conversions", func(t *testing.T) {
+		either := Ok(1).ToEither()
+		assert.Equal(t, Right[error, int](1), either)
+		assert.Equal(t, Ok(1), Result[int]{Either: either})
+	})
+}
@@ -0,0 +1,119 @@
+// Package utils ...
+package utils
+
+import "reflect"
+
+// Cloner is implemented by types that know how to copy themselves
+// DeepClone prefers Clone over reflection whenever it is available
+type Cloner[A any] interface {
+	Clone() A
+}
+
+// DeepClone copies value recursively, so the copy doesn't share slices, maps or pointers with the original
+// values that implement Cloner are copied using their Clone method
+// please note that unexported struct fields, channels and functions are copied shallowly
+// since reflection can't set unexported fields
+func DeepClone[A any](value A) A {
+	var cloned A
+	src := reflect.ValueOf(&value).Elem()
+	if isNilRef(src) {
+		return cloned
+	}
+	if cloner, ok := any(value).(Cloner[A]); ok {
+		return cloner.Clone()
+	}
+	reflect.ValueOf(&cloned).Elem().Set(deepClone(src, map[visit]reflect.Value{}))
+	return cloned
+}
+
+// visit identifies a reference that was already cloned, to preserve sharing and handle cycles
+type visit struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+// deepClone copies src recursively and returns a value of the same type
+func deepClone(src reflect.Value, visited map[visit]reflect.Value) reflect.Value {
+	if cloned, ok := cloneWithMethod(src); ok {
+		return cloned
+	}
+
+	dst := reflect.New(src.Type()).Elem()
+	switch src.Kind() {
+	case reflect.Ptr:
+		if src.IsNil() {
+			return dst
+		}
+		key := visit{ptr: src.Pointer(), typ: src.Type()}
+		if cloned, ok := visited[key]; ok {
+			return cloned
+		}
+		ptr := reflect.New(src.Type().Elem())
+		visited[key] = ptr
+		ptr.Elem().Set(deepClone(src.Elem(), visited))
+		dst.Set(ptr)
+	case reflect.Interface:
+		if src.IsNil() {
+			return dst
+		}
+		dst.Set(deepClone(src.Elem(), visited))
+	case reflect.Slice:
+		if src.IsNil() {
+			return dst
+		}
+		dst.Set(reflect.MakeSlice(src.Type(), src.Len(), src.Cap()))
+		for i := 0; i < src.Len(); i++ {
+			dst.Index(i).Set(deepClone(src.Index(i), visited))
+		}
+	case reflect.Array:
+		for i := 0; i < src.Len(); i++ {
+			dst.Index(i).Set(deepClone(src.Index(i), visited))
+		}
+	case reflect.Map:
+		if src.IsNil() {
+			return dst
+		}
+		key := visit{ptr: src.Pointer(), typ: src.Type()}
+		if cloned, ok := visited[key]; ok {
+			return cloned
+		}
+		dst.Set(reflect.MakeMapWithSize(src.Type(), src.Len()))
+		visited[key] = dst
+		iter := src.MapRange()
+		for iter.Next() {
+			dst.SetMapIndex(deepClone(iter.Key(), visited), deepClone(iter.Value(), visited))
+		}
+	case reflect.Struct:
+		// shallow copy first to keep the unexported fields
+		dst.Set(src)
+		for i := 0; i < src.NumField(); i++ {
+			if field := dst.Field(i); field.CanSet() {
+				field.Set(deepClone(src.Field(i), visited))
+			}
+		}
+	default:
+		dst.Set(src)
+	}
+	return dst
+}
+
+// cloneWithMethod copies src using its Clone method if it has one that returns the same type
+func cloneWithMethod(src reflect.Value) (reflect.Value, bool) {
+	if !src.CanInterface() || isNilRef(src) {
+		return reflect.Value{}, false
+	}
+	method := src.MethodByName("Clone")
+	if !method.IsValid() {
+		return reflect.Value{}, false
+	}
+	methodType := method.Type()
+	if methodType.NumIn() != 0 || methodType.NumOut() != 1 || methodType.Out(0) != src.Type() {
+		return reflect.Value{}, false
+	}
+	return method.Call(nil)[0], true
+}
+
+// isNilRef reports whether src is a nil pointer or a nil interface, which can't be used as a Clone receiver
+func isNilRef(src reflect.Value) bool {
+	return (src.Kind() == reflect.Ptr || src.Kind() == reflect.Interface) && src.IsNil()
+}
@@ -0,0 +1,165 @@
+package utils
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+type cloneTestNode struct {
+	Name     string
+	Tags     []string
+	Attrs    map[string]int
+	Next     *cloneTestNode
+	internal []int
+}
+
+type cloneTestCounter struct {
+	value  int
+	clones *int
+}
+
+func (c cloneTestCounter) Clone() cloneTestCounter {
+	*c.clones++
+	return cloneTestCounter{value: c.value, clones: c.clones}
+}
+
+type cloneTestCloner interface {
+	Clone() cloneTestCloner
+}
+
+type cloneTestHolder struct {
+	C cloneTestCloner
+}
+
+type cloneTestClonerImpl struct {
+	value int
+}
+
+func (c *cloneTestClonerImpl) Clone() cloneTestCloner {
+	return &cloneTestClonerImpl{value: c.value}
+}
+
+type cloneTestLinked struct {
+	value int
+}
+
+func (n *cloneTestLinked) Clone() *cloneTestLinked {
+	return &cloneTestLinked{value: n.value}
+}
+
+func TestDeepClone(t *testing.T) {
+	t.Run("primitives", func(t *testing.T) {
+		assert.Equal(t, 10, DeepClone(10))
+		assert.Equal(t, "saddam", DeepClone("saddam"))
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		original := []int{1, 2, 3}
+		cloned := DeepClone(original)
+		cloned[0] = 100
+		assert.Equal(t, []int{1, 2, 3}, original)
+		assert.Equal(t, []int{100, 2, 3}, cloned)
+	})
+
+	t.Run("nested map", func(t *testing.T) {
+		original := map[string][]int{"a": {1, 2}}
+		cloned := DeepClone(original)
+		cloned["a"][0] = 100
+		cloned["b"] = []int{3}
+		assert.Equal(t, map[string][]int{"a": {1, 2}}, original)
+	})
+
+	t.Run("nil values", func(t *testing.T) {
+		var slice []int
+		var m map[string]int
+		var ptr *cloneTestNode
+		var iface any
+		assert.Nil(t, DeepClone(slice))
+		assert.Nil(t, DeepClone(m))
+		assert.Nil(t, DeepClone(ptr))
+		assert.Nil(t, DeepClone(iface))
+	})
+
+	t.Run("struct with pointers", func(t *testing.T) {
+		original := cloneTestNode{
+			Name:     "head",
+			Tags:     []string{"a"},
+			Attrs:    map[string]int{"a": 1},
+			Next:     &cloneTestNode{Name: "tail"},
+			internal: []int{1},
+		}
+		cloned := DeepClone(original)
+		assert.Equal(t, original, cloned)
+
+		cloned.Tags[0] = "b"
+		cloned.Attrs["a"] = 2
+		cloned.Next.Name = "changed"
+		assert.Equal(t, "a", original.Tags[0])
+		assert.Equal(t, 1, original.Attrs["a"])
+		assert.Equal(t, "tail", original.Next.Name)
+
+		// unexported fields are copied shallowly
+		cloned.internal[0] = 2
+		assert.Equal(t, 2, original.internal[0])
+	})
+
+	t.Run("cycles", func(t *testing.T) {
+		original := &cloneTestNode{Name: "a"}
+		original.Next = &cloneTestNode{Name: "b", Next: original}
+
+		cloned := DeepClone(original)
+		assert.NotSame(t, original, cloned)
+		assert.Same(t, cloned, cloned.Next.Next)
+		assert.Equal(t, "b", cloned.Next.Name)
+	})
+
+	t.Run("array of slices", func(t *testing.T) {
+		original := [2][]int{{1}, {2}}
+		cloned := DeepClone(original)
+		cloned[0][0] = 100
+		assert.Equal(t, 1, original[0][0])
+	})
+
+	t.Run("interface values", func(t *testing.T) {
+		original := []any{[]int{1}, map[string]int{"a": 1}}
+		cloned := DeepClone(original)
+		cloned[0].([]int)[0] = 100
+		assert.Equal(t, 1, original[0].([]int)[0])
+	})
+
+	t.Run("cloner", func(t *testing.T) {
+		clones := 0
+		original := cloneTestCounter{value: 1, clones: &clones}
+		cloned := DeepClone(original)
+		assert.Equal(t, 1, cloned.value)
+		assert.Equal(t, 1, clones)
+
+		// nested cloners are used as well
+		DeepClone([]cloneTestCounter{original, original})
+		assert.Equal(t, 3, clones)
+	})
+	t.Run("nil pointer with Clone", func(t *testing.T) {
+		var original *cloneTestLinked
+		assert.NotPanics(t, func() {
+			assert.Nil(t, DeepClone(original))
+		})
+		node := &cloneTestLinked{value: 1}
+		cloned := DeepClone(node)
+		assert.Equal(t, node, cloned)
+		assert.NotSame(t, node, cloned)
+		var iface cloneTestCloner
+		assert.Nil(t, DeepClone(iface))
+	})
+
+	t.Run("interface with Clone", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			cloned := DeepClone(cloneTestHolder{C: nil})
+			assert.Nil(t, cloned.C)
+		})
+
+		original := cloneTestHolder{C: &cloneTestClonerImpl{value: 1}}
+		cloned := DeepClone(original)
+		assert.Equal(t, original, cloned)
+		assert.NotSame(t, original.C, cloned.C)
+	})
+}
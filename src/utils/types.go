@@ -6,3 +6,7 @@ type Mapper[A, B any] func(A) B
 
 // Predicate function used for filters and checking
 type Predicate[A any] func(value A) bool
+
+// Comparator function used for ordering, it returns
+// -1 if a is less than b, 0 if a equals b and +1 if a is greater than b
+type Comparator[A any] func(a, b A) int
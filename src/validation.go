@@ -0,0 +1,160 @@
+// Package src ...
+package src
+
+import (
+	"errors"
+	"fmt"
+	"github.com/sghaida/fpv2/src/utils"
+)
+
+// Validation holds either a valid value of type A or all the errors of type E that were found
+// unlike Either which stops at the first Left, combining Validations accumulates the errors
+// of all the invalid inputs, which fits form and config validation
+// the zero value of Validation is valid and holds the zero value of A
+type Validation[E, A any] struct {
+	value A
+	errs  []E
+}
+
+// Valid create a valid Validation that holds value
+func Valid[E, A any](value A) Validation[E, A] {
+	return Validation[E, A]{value: value}
+}
+
+// Invalid create an invalid Validation that holds at least one error
+func Invalid[E, A any](err E, errs ...E) Validation[E, A] {
+	return Validation[E, A]{errs: append([]E{err}, errs...)}
+}
+
+// ValidationFromEither converts Either to Validation, Left => Invalid, Right => Valid
+func ValidationFromEither[E, A any](either Either[E, A]) Validation[E, A] {
+	if either.IsLeft() {
+		return Invalid[E, A](either.left)
+	}
+	return Valid[E](either.right)
+}
+
+// IsValid return True if there are no errors
+func (v Validation[E, A]) IsValid() bool {
+	return len(v.errs) == 0
+}
+
+// IsInvalid return True if there is at least one error
+func (v Validation[E, A]) IsInvalid() bool {
+	return !v.IsValid()
+}
+
+// Get return the valid value, in the context of invalid Validation the zero value of A is returned
+func (v Validation[E, A]) Get() A {
+	return v.value
+}
+
+// Errors return a copy of the accumulated errors
+func (v Validation[E, A]) Errors() []E {
+	out := make([]E, len(v.errs))
+	copy(out, v.errs)
+	return out
+}
+
+// ToEither converts Validation to Either, Invalid => Left of all the errors, Valid => Right
+func (v Validation[E, A]) ToEither() Either[[]E, A] {
+	if v.IsInvalid() {
+		return Left[[]E, A](v.Errors())
+	}
+	return RightUnchecked[[]E](v.value)
+}
+
+// Err return nil if the Validation is valid, otherwise all the errors are combined using errors.Join
+// errors of type E that don't implement error are converted using their default format
+func (v Validation[E, A]) Err() error {
+	if v.IsValid() {
+		return nil
+	}
+	errs := make([]error, 0, len(v.errs))
+	for _, e := range v.errs {
+		if err, ok := any(e).(error); ok {
+			errs = append(errs, err)
+			continue
+		}
+		errs = append(errs, fmt.Errorf("%v", e))
+	}
+	return errors.Join(errs...)
+}
+
+// ValidationMap applies mapper on the valid value and keep the errors of invalid Validation
+func ValidationMap[E, A, B any](v Validation[E, A], mapper utils.Mapper[A, B]) Validation[E, B] {
+	if v.IsInvalid() {
+		return Validation[E, B]{errs: v.errs}
+	}
+	return Valid[E](mapper(v.value))
+}
+
+// ValidationMap2 applies fn on the values of 2 Validations if both are valid
+// otherwise the errors of all the invalid Validations are concatenated in order
+func ValidationMap2[E, A, B, C any](a Validation[E, A], b Validation[E, B], fn func(A, B) C) Validation[E, C] {
+	if errs := concatErrors(a.errs, b.errs); len(errs) > 0 {
+		return Validation[E, C]{errs: errs}
+	}
+	return Valid[E](fn(a.value, b.value))
+}
+
+// ValidationMap3 applies fn on the values of 3 Validations if all of them are valid
+// otherwise the errors of all the invalid Validations are concatenated in order
+func ValidationMap3[E, A, B, C, D any](
+	a Validation[E, A], b Validation[E, B], c Validation[E, C], fn func(A, B, C) D,
+) Validation[E, D] {
+	if errs := concatErrors(a.errs, b.errs, c.errs); len(errs) > 0 {
+		return Validation[E, D]{errs: errs}
+	}
+	return Valid[E](fn(a.value, b.value, c.value))
+}
+
+// ValidationMap4 applies fn on the values of 4 Validations if all of them are valid
+// otherwise the errors of all the invalid Validations are concatenated in order
+func ValidationMap4[E, A, B, C, D, F any](
+	a Validation[E, A], b Validation[E, B], c Validation[E, C], d Validation[E, D], fn func(A, B, C, D) F,
+) Validation[E, F] {
+	if errs := concatErrors(a.errs, b.errs, c.errs, d.errs); len(errs) > 0 {
+		return Validation[E, F]{errs: errs}
+	}
+	return Valid[E](fn(a.value, b.value, c.value, d.value))
+}
+
+// ValidationMap5 applies fn on the values of 5 Validations if all of them are valid
+// otherwise the errors of all the invalid Validations are concatenated in order
+func ValidationMap5[E, A, B, C, D, F, G any](
+	a Validation[E, A], b Validation[E, B], c Validation[E, C], d Validation[E, D], f Validation[E, F],
+	fn func(A, B, C, D, F) G,
+) Validation[E, G] {
+	if errs := concatErrors(a.errs, b.errs, c.errs, d.errs, f.errs); len(errs) > 0 {
+		return Validation[E, G]{errs: errs}
+	}
+	return Valid[E](fn(a.value, b.value, c.value, d.value, f.value))
+}
+
+// ValidationSequence converts a slice of Validations into a Validation of slice
+// it is valid if all the Validations are valid, otherwise it holds the errors of all of them
+func ValidationSequence[E, A any](validations []Validation[E, A]) Validation[E, []A] {
+	values := make([]A, 0, len(validations))
+	var errs []E
+	for _, v := range validations {
+		if v.IsInvalid() {
+			errs = append(errs, v.errs...)
+			continue
+		}
+		values = append(values, v.value)
+	}
+	if len(errs) > 0 {
+		return Validation[E, []A]{errs: errs}
+	}
+	return Valid[E](values)
+}
+
+// concatErrors concatenates the errors into a new slice
+func concatErrors[E any](errs ...[]E) []E {
+	var out []E
+	for _, e := range errs {
+		out = append(out, e...)
+	}
+	return out
+}
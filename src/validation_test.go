@@ -0,0 +1,154 @@
+package src
+
+import (
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+)
+
+type validationTestConfig struct {
+	Host string
+	Port int
+}
+
+var (
+	errEmptyHost   = errors.New("host is empty")
+	errInvalidPort = errors.New("port is out of range")
+)
+
+func validateHost(host string) Validation[error, string] {
+	if strings.TrimSpace(host) == "" {
+		return Invalid[error, string](errEmptyHost)
+	}
+	return Valid[error](host)
+}
+
+func validatePort(port int) Validation[error, int] {
+	if port <= 0 || port > 65535 {
+		return Invalid[error, int](errInvalidPort)
+	}
+	return Valid[error](port)
+}
+
+func TestValid(t *testing.T) {
+	v := Valid[error](10)
+	assert.True(t, v.IsValid())
+	assert.False(t, v.IsInvalid())
+	assert.Equal(t, 10, v.Get())
+	assert.Empty(t, v.Errors())
+	assert.NoError(t, v.Err())
+
+	var zero Validation[error, int]
+	assert.True(t, zero.IsValid())
+}
+
+func TestInvalid(t *testing.T) {
+	v := Invalid[string, int]("a", "b")
+	assert.True(t, v.IsInvalid())
+	assert.Equal(t, []string{"a", "b"}, v.Errors())
+	assert.Equal(t, 0, v.Get())
+
+	// Errors returns a copy
+	v.Errors()[0] = "changed"
+	assert.Equal(t, []string{"a", "b"}, v.Errors())
+}
+
+func TestValidation_Err(t *testing.T) {
+	v := Invalid[error, int](errEmptyHost, errInvalidPort)
+	err := v.Err()
+	assert.ErrorIs(t, err, errEmptyHost)
+	assert.ErrorIs(t, err, errInvalidPort)
+	assert.EqualError(t, err, "host is empty\nport is out of range")
+
+	assert.EqualError(t, Invalid[string, int]("a", "b").Err(), "a\nb")
+}
+
+func TestValidationMap(t *testing.T) {
+	double := func(value int) int { return value * 2 }
+
+	assert.Equal(t, 20, ValidationMap(Valid[error](10), double).Get())
+
+	invalid := ValidationMap(Invalid[error, int](errInvalidPort), double)
+	assert.Equal(t, []error{errInvalidPort}, invalid.Errors())
+}
+
+func TestValidationMapN(t *testing.T) {
+	newConfig := func(host string, port int) validationTestConfig {
+		return validationTestConfig{Host: host, Port: port}
+	}
+
+	t.Run("map2 valid", func(t *testing.T) {
+		v := ValidationMap2(validateHost("localhost"), validatePort(8080), newConfig)
+		assert.True(t, v.IsValid())
+		assert.Equal(t, validationTestConfig{Host: "localhost", Port: 8080}, v.Get())
+	})
+
+	t.Run("map2 accumulates all the errors", func(t *testing.T) {
+		v := ValidationMap2(validateHost(" "), validatePort(-1), newConfig)
+		assert.True(t, v.IsInvalid())
+		assert.Equal(t, []error{errEmptyHost, errInvalidPort}, v.Errors())
+	})
+
+	t.Run("map3", func(t *testing.T) {
+		sum := func(a, b, c int) int { return a + b + c }
+		assert.Equal(t, 6, ValidationMap3(Valid[string](1), Valid[string](2), Valid[string](3), sum).Get())
+
+		v := ValidationMap3(Invalid[string, int]("a"), Valid[string](2), Invalid[string, int]("c"), sum)
+		assert.Equal(t, []string{"a", "c"}, v.Errors())
+	})
+
+	t.Run("map4", func(t *testing.T) {
+		sum := func(a, b, c, d int) int { return a + b + c + d }
+		assert.Equal(t, 10, ValidationMap4(
+			Valid[string](1), Valid[string](2), Valid[string](3), Valid[string](4), sum,
+		).Get())
+
+		v := ValidationMap4(
+			Invalid[string, int]("a", "b"), Valid[string](2), Valid[string](3), Invalid[string, int]("d"), sum,
+		)
+		assert.Equal(t, []string{"a", "b", "d"}, v.Errors())
+	})
+
+	t.Run("map5", func(t *testing.T) {
+		sum := func(a, b, c, d, e int) int { return a + b + c + d + e }
+		assert.Equal(t, 15, ValidationMap5(
+			Valid[string](1), Valid[string](2), Valid[string](3), Valid[string](4), Valid[string](5), sum,
+		).Get())
+
+		v := ValidationMap5(
+			Valid[string](1), Invalid[string, int]("b"), Valid[string](3), Valid[string](4), Invalid[string, int]("e"), sum,
+		)
+		assert.Equal(t, []string{"b", "e"}, v.Errors())
+	})
+}
+
+func TestValidationSequence(t *testing.T) {
+	valid := ValidationSequence([]Validation[error, int]{validatePort(80), validatePort(443)})
+	assert.Equal(t, []int{80, 443}, valid.Get())
+
+	invalid := ValidationSequence([]Validation[error, int]{validatePort(0), validatePort(443), validatePort(70000)})
+	assert.Equal(t, []error{errInvalidPort, errInvalidPort}, invalid.Errors())
+}
+
+func TestValidation_Either(t *testing.T) {
+	t.Run("from either", func(t *testing.T) {
+		assert.Equal(t, 10, ValidationFromEither(Right[error, int](10)).Get())
+
+		v := ValidationFromEither(Left[error, int](errInvalidPort))
+		assert.Equal(t, []error{errInvalidPort}, v.Errors())
+	})
+
+	t.Run("to either", func(t *testing.T) {
+		right := Valid[error](10).ToEither()
+		assert.True(t, right.IsRight())
+		assert.Equal(t, 10, right.right)
+
+		zero := Valid[error](0).ToEither()
+		assert.True(t, zero.IsRight())
+
+		left := Invalid[error, int](errEmptyHost, errInvalidPort).ToEither()
+		assert.True(t, left.IsLeft())
+		assert.Equal(t, []error{errEmptyHost, errInvalidPort}, left.left)
+	})
+}